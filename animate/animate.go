@@ -0,0 +1,91 @@
+// Package animate plays the states of grid simulations in a terminal, with controls for pausing, stepping through
+// and changing the speed of the animation, and exports them as PNG images or animated GIFs.
+package animate
+
+import (
+	"errors"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// ErrNoAnimation is returned by Animate for a part that has no animation.
+var ErrNoAnimation = errors.New("no animation for this part")
+
+// Animator is implemented by solvers that can show how they solve a part as a sequence of frames. The frames are
+// produced as they are needed, so stopping early does not pay for the rest of the simulation.
+type Animator interface {
+	Animate(part int) (iter.Seq[Frame], error)
+}
+
+// Colour is a terminal colour.
+type Colour int
+
+const (
+	Default Colour = iota
+	Red
+	Green
+	Yellow
+	Blue
+	Magenta
+	Cyan
+	White
+	Grey
+)
+
+// code returns the ANSI select graphic rendition code that sets the foreground to the colour.
+func (c Colour) code() string {
+	switch {
+	case c == Grey:
+		return "90"
+	case c >= Red && c <= White:
+		// The colours are declared in the same order as their codes, from 31 for red to 37 for white.
+		return "3" + strconv.Itoa(int(c))
+	}
+	return "39"
+}
+
+// Frame is a single picture of a simulation.
+type Frame struct {
+	Grid *grid.Grid[byte]
+	// Colours gives the colour of the cells holding each value. Other cells are drawn in the default colour.
+	Colours map[byte]Colour
+	// Highlights overrides the colour of individual cells.
+	Highlights map[grid.Point]Colour
+	// Caption is a line of text shown beneath the grid.
+	Caption string
+}
+
+// colour returns the colour of the cell at a point.
+func (f Frame) colour(p grid.Point, cell byte) Colour {
+	if colour, ok := f.Highlights[p]; ok {
+		return colour
+	}
+	return f.Colours[cell]
+}
+
+// Render draws the frame as lines of text, using ANSI escape codes to colour the cells. Each line is followed by a
+// code that clears the rest of the line, so that a frame can be drawn over a wider one.
+func (f Frame) Render() string {
+	var sb strings.Builder
+	for row := 0; row < f.Grid.Height(); row++ {
+		current := Default
+		for col := 0; col < f.Grid.Width(); col++ {
+			p := grid.Point{Row: row, Col: col}
+			cell := f.Grid.At(p)
+			if colour := f.colour(p, cell); colour != current {
+				sb.WriteString("\x1b[" + colour.code() + "m")
+				current = colour
+			}
+			sb.WriteByte(cell)
+		}
+		if current != Default {
+			sb.WriteString("\x1b[39m")
+		}
+		sb.WriteString("\x1b[K\n")
+	}
+	sb.WriteString(f.Caption + "\x1b[K\n")
+	return sb.String()
+}
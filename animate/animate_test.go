@@ -0,0 +1,177 @@
+package animate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/gif"
+	"image/png"
+	"iter"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+func TestRender(t *testing.T) {
+	g, err := grid.FromLines[byte]([]string{"#.O", "..O"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := Frame{
+		Grid:       g,
+		Colours:    map[byte]Colour{'O': Yellow},
+		Highlights: map[grid.Point]Colour{{Row: 1, Col: 0}: Red},
+		Caption:    "step 1",
+	}
+	want := "#.\x1b[33mO\x1b[39m\x1b[K\n" +
+		"\x1b[31m.\x1b[39m.\x1b[33mO\x1b[39m\x1b[K\n" +
+		"step 1\x1b[K\n"
+	if got := frame.Render(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// numberedFrames yields frames captioned with their numbers, counting how many were asked for.
+func numberedFrames(n int, produced *int) iter.Seq[Frame] {
+	return func(yield func(Frame) bool) {
+		for i := 1; i <= n; i++ {
+			*produced = i
+			if !yield(Frame{Grid: grid.New(1, 1, byte('.')), Caption: "caption " + strconv.Itoa(i)}) {
+				return
+			}
+		}
+	}
+}
+
+func TestPlay(t *testing.T) {
+	var buffer bytes.Buffer
+	produced := 0
+	if err := (&Player{}).Play(context.Background(), &buffer, numberedFrames(5, &produced)); err != nil {
+		t.Fatal(err)
+	}
+	if produced != 5 || !strings.Contains(buffer.String(), "caption 5") {
+		t.Errorf("played %d frames, want 5", produced)
+	}
+}
+
+func TestPlayControls(t *testing.T) {
+	keys := make(chan byte, 4)
+	for _, key := range []byte(" nnq") {
+		keys <- key
+	}
+	var buffer bytes.Buffer
+	produced := 0
+	// The frames would each be shown for an hour if the keys did not step through them.
+	player := &Player{Delay: time.Hour, Keys: keys}
+	if err := player.Play(context.Background(), &buffer, numberedFrames(10, &produced)); err != nil {
+		t.Fatal(err)
+	}
+	if produced != 3 || !strings.Contains(buffer.String(), "frame 3 (paused)") {
+		t.Errorf("stopped after %d frames, want 3", produced)
+	}
+}
+
+func TestPlayCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	produced := 0
+	err := (&Player{Delay: time.Hour}).Play(ctx, &bytes.Buffer{}, numberedFrames(10, &produced))
+	if !errors.Is(err, context.Canceled) || produced != 1 {
+		t.Errorf("got error %v after %d frames, want %v after 1", err, produced, context.Canceled)
+	}
+}
+
+func TestImage(t *testing.T) {
+	g, err := grid.FromLines[byte]([]string{"#.", ".O"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := Frame{Grid: g, Colours: map[byte]Colour{'O': Yellow}, Highlights: map[grid.Point]Colour{{Row: 1, Col: 0}: Red}}
+	img := frame.Image(2)
+	if bounds := img.Bounds(); bounds.Dx() != 4 || bounds.Dy() != 4 {
+		t.Fatalf("got an image of %v, want 4x4", bounds)
+	}
+	for _, test := range []struct {
+		x, y   int
+		colour Colour
+	}{{1, 1, Default}, {3, 0, background}, {0, 3, Red}, {2, 2, Yellow}} {
+		if got := img.ColorIndexAt(test.x, test.y); got != uint8(test.colour) {
+			t.Errorf("pixel (%d, %d) has colour %d, want %d", test.x, test.y, got, test.colour)
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := WritePNG(&buffer, frame, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := png.Decode(&buffer); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWriteGIF(t *testing.T) {
+	for every, want := range map[int]int{1: 5, 2: 3, 3: 3, 10: 2} {
+		var buffer bytes.Buffer
+		produced := 0
+		if err := WriteGIF(&buffer, numberedFrames(5, &produced), 1, 50*time.Millisecond, every); err != nil {
+			t.Fatal(err)
+		}
+		animation, err := gif.DecodeAll(&buffer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(animation.Image) != want || animation.Delay[0] != 5 {
+			t.Errorf("every %d: got %d frames with delay %d, want %d frames with delay 5", every,
+				len(animation.Image), animation.Delay[0], want)
+		}
+	}
+}
+
+func TestWriteGIFImages(t *testing.T) {
+	g, err := grid.FromLines[byte]([]string{"#..", ".O#"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := Frame{Grid: g, Colours: map[byte]Colour{'O': Yellow, '#': White}}
+	frames := func(yield func(Frame) bool) {
+		for range 3 {
+			if !yield(frame) {
+				return
+			}
+		}
+	}
+	var buffer bytes.Buffer
+	if err := WriteGIF(&buffer, frames, 3, time.Second, 1); err != nil {
+		t.Fatal(err)
+	}
+	animation, err := gif.DecodeAll(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := frame.Image(3)
+	if len(animation.Image) != 3 || animation.LoopCount != 0 {
+		t.Fatalf("got %d frames looping %d times, want 3 frames looping forever", len(animation.Image),
+			animation.LoopCount)
+	}
+	for i, img := range animation.Image {
+		if img.Bounds() != want.Bounds() || !bytes.Equal(img.Pix, want.Pix) {
+			t.Errorf("frame %d differs from the image of the frame", i)
+		}
+		if r, g, b, _ := img.At(4, 4).RGBA(); [3]uint32{r, g, b} != [3]uint32{0xf0f0, 0xc6c6, 0x2c2c} {
+			t.Errorf("frame %d: got colour %x, %x, %x for yellow", i, r, g, b)
+		}
+	}
+
+	mixed := func(yield func(Frame) bool) {
+		_ = yield(frame) && yield(Frame{Grid: grid.New(1, 1, byte('.'))})
+	}
+	if err := WriteGIF(&buffer, mixed, 1, time.Second, 1); err == nil {
+		t.Error("wrote frames of different sizes")
+	}
+	if err := WriteGIF(&buffer, numberedFrames(0, new(int)), 1, time.Second, 1); err == nil {
+		t.Error("wrote a GIF with no frames")
+	}
+}
@@ -0,0 +1,144 @@
+package animate
+
+import (
+	"bufio"
+	"compress/lzw"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+)
+
+// gifWriter writes an animated GIF one frame at a time, so that a long animation never has to be held in memory the
+// way gif.EncodeAll needs. Every frame uses the global palette and must be the size of the first.
+type gifWriter struct {
+	w *bufio.Writer
+	// size is the size of the frames, which is zero until the first frame has been written.
+	size image.Point
+	// tableBits is the number of bits in the index of a colour in the padded palette.
+	tableBits int
+	err       error
+}
+
+// newGIFWriter creates a writer of an animated GIF to w.
+func newGIFWriter(w io.Writer) *gifWriter {
+	tableBits := 1
+	for 1<<tableBits < len(palette) {
+		tableBits++
+	}
+	return &gifWriter{w: bufio.NewWriter(w), tableBits: tableBits}
+}
+
+// write writes bytes, holding on to the first error so that the rest can be skipped.
+func (g *gifWriter) write(data ...byte) {
+	if g.err == nil {
+		_, g.err = g.w.Write(data)
+	}
+}
+
+// writeUint16 writes a little-endian 16-bit number.
+func (g *gifWriter) writeUint16(n int) {
+	g.write(binary.LittleEndian.AppendUint16(nil, uint16(n))...)
+}
+
+// header writes the header, the global palette and the extension that makes the animation loop forever.
+func (g *gifWriter) header(size image.Point) {
+	g.size = size
+	g.write([]byte("GIF89a")...)
+	g.writeUint16(size.X)
+	g.writeUint16(size.Y)
+	// The flags give a global colour table of 2^tableBits entries, each colour with 8 bits per channel.
+	g.write(0x80|0x70|byte(g.tableBits-1), 0, 0)
+	for i := range 1 << g.tableBits {
+		if i < len(palette) {
+			r, gr, b, _ := palette[i].RGBA()
+			g.write(byte(r>>8), byte(gr>>8), byte(b>>8))
+		} else {
+			g.write(0, 0, 0)
+		}
+	}
+	g.write(0x21, 0xff, 11)
+	g.write([]byte("NETSCAPE2.0")...)
+	g.write(3, 1, 0, 0, 0) // loop forever
+}
+
+// frame writes an image shown for the given number of hundredths of a second.
+func (g *gifWriter) frame(img *image.Paletted, centiseconds int) error {
+	size := img.Bounds().Size()
+	if g.size == (image.Point{}) {
+		if size.X > 0xffff || size.Y > 0xffff {
+			return fmt.Errorf("a %dx%d image is too large for a GIF", size.X, size.Y)
+		}
+		g.header(size)
+	} else if size != g.size {
+		return fmt.Errorf("a %dx%d frame follows %dx%d frames", size.X, size.Y, g.size.X, g.size.Y)
+	}
+
+	g.write(0x21, 0xf9, 4, 0)
+	g.writeUint16(centiseconds)
+	g.write(0, 0)
+	g.write(0x2c)
+	g.writeUint16(0)
+	g.writeUint16(0)
+	g.writeUint16(size.X)
+	g.writeUint16(size.Y)
+	g.write(0)
+
+	// The image data is compressed with LZW and split into blocks of at most 255 bytes.
+	litWidth := max(g.tableBits, 2)
+	g.write(byte(litWidth))
+	blocks := &blockWriter{g: g}
+	compressor := lzw.NewWriter(blocks, lzw.LSB, litWidth)
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y && g.err == nil; y++ {
+		start := img.PixOffset(img.Rect.Min.X, y)
+		if _, err := compressor.Write(img.Pix[start : start+size.X]); err != nil && g.err == nil {
+			g.err = err
+		}
+	}
+	if err := compressor.Close(); err != nil && g.err == nil {
+		g.err = err
+	}
+	blocks.flush()
+	g.write(0)
+	return g.err
+}
+
+// close writes the end of the GIF.
+func (g *gifWriter) close() error {
+	if g.size == (image.Point{}) {
+		return errors.New("no frames to write")
+	}
+	g.write(0x3b)
+	if g.err == nil {
+		g.err = g.w.Flush()
+	}
+	return g.err
+}
+
+// blockWriter splits the data written to it into the blocks of a GIF's image data.
+type blockWriter struct {
+	g      *gifWriter
+	block  [255]byte
+	length int
+}
+
+func (b *blockWriter) Write(data []byte) (int, error) {
+	for _, c := range data {
+		b.block[b.length] = c
+		b.length++
+		if b.length == len(b.block) {
+			b.flush()
+		}
+	}
+	return len(data), b.g.err
+}
+
+// flush writes the block so far, if it holds anything.
+func (b *blockWriter) flush() {
+	if b.length > 0 {
+		b.g.write(byte(b.length))
+		b.g.write(b.block[:b.length]...)
+		b.length = 0
+	}
+}
@@ -0,0 +1,79 @@
+package animate
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"iter"
+	"time"
+)
+
+// background is the colour drawn behind empty cells in images, which cannot be used in frames.
+const background = Grey + 1
+
+// palette gives the colour of each Colour in an image, indexed by the Colour.
+var palette = color.Palette{
+	Default:    color.RGBA{0xcc, 0xcc, 0xcc, 0xff},
+	Red:        color.RGBA{0xdc, 0x32, 0x2f, 0xff},
+	Green:      color.RGBA{0x4e, 0xb8, 0x4e, 0xff},
+	Yellow:     color.RGBA{0xf0, 0xc6, 0x2c, 0xff},
+	Blue:       color.RGBA{0x3a, 0x7b, 0xd5, 0xff},
+	Magenta:    color.RGBA{0xc8, 0x4c, 0xc8, 0xff},
+	Cyan:       color.RGBA{0x2a, 0xb5, 0xb5, 0xff},
+	White:      color.RGBA{0xff, 0xff, 0xff, 0xff},
+	Grey:       color.RGBA{0x70, 0x70, 0x70, 0xff},
+	background: color.RGBA{0x10, 0x10, 0x20, 0xff},
+}
+
+// Image draws the frame as an image in which each cell is a square scale pixels wide. Cells holding '.', the empty
+// cell in every puzzle map, are left as background unless they are highlighted. The caption is not drawn.
+func (f Frame) Image(scale int) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, f.Grid.Width()*scale, f.Grid.Height()*scale), palette)
+	for p, cell := range f.Grid.All() {
+		colour := background
+		if _, highlighted := f.Highlights[p]; highlighted || cell != '.' {
+			colour = f.colour(p, cell)
+		}
+		for y := p.Row * scale; y < (p.Row+1)*scale; y++ {
+			for x := p.Col * scale; x < (p.Col+1)*scale; x++ {
+				img.SetColorIndex(x, y, uint8(colour))
+			}
+		}
+	}
+	return img
+}
+
+// WritePNG writes a frame to w as a PNG image, with each cell scale pixels wide.
+func WritePNG(w io.Writer, frame Frame, scale int) error {
+	return png.Encode(w, frame.Image(scale))
+}
+
+// WriteGIF writes frames to w as an animated GIF that loops forever, with each cell scale pixels wide and each frame
+// shown for delay. Only every nth frame is kept, along with the last, to keep long animations to a manageable size.
+// Each frame is written as it arrives, so that memory use does not grow with the length of the animation. All of the
+// frames must have grids of the same size.
+func WriteGIF(w io.Writer, frames iter.Seq[Frame], scale int, delay time.Duration, every int) error {
+	animation := newGIFWriter(w)
+	// GIF delays are in hundredths of a second.
+	centiseconds := max(int(delay/(10*time.Millisecond)), 1)
+	var last *Frame
+	number := 0
+	for frame := range frames {
+		if number%every == 0 {
+			if err := animation.frame(frame.Image(scale), centiseconds); err != nil {
+				return err
+			}
+			last = nil
+		} else {
+			last = &frame
+		}
+		number++
+	}
+	if last != nil {
+		if err := animation.frame(last.Image(scale), centiseconds); err != nil {
+			return err
+		}
+	}
+	return animation.close()
+}
@@ -0,0 +1,101 @@
+package animate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"time"
+)
+
+// minDelay and maxDelay bound the time each frame can be shown for when the speed is changed.
+const (
+	minDelay = time.Millisecond
+	maxDelay = 2 * time.Second
+)
+
+// Player plays frames in a terminal, drawing each one over the last.
+type Player struct {
+	// Delay is the time each frame is shown for.
+	Delay time.Duration
+	// Keys delivers the keys pressed by the viewer, or is nil if the animation cannot be controlled. The keys are:
+	//
+	//	space  pause or resume
+	//	n      show the next frame while paused
+	//	+      play twice as fast
+	//	-      play half as fast
+	//	q      stop
+	Keys <-chan byte
+}
+
+// Play draws the frames to w until they run out, the viewer stops the animation or ctx is done. The last frame drawn
+// is left on the screen.
+func (p *Player) Play(ctx context.Context, w io.Writer, frames iter.Seq[Frame]) error {
+	if _, err := io.WriteString(w, "\x1b[?25l\x1b[2J"); err != nil {
+		return err
+	}
+	defer io.WriteString(w, "\x1b[?25h")
+
+	delay, paused, number := p.Delay, false, 0
+	for frame := range frames {
+		number++
+		rendered := frame.Render()
+		draw := func() error {
+			_, err := fmt.Fprintf(w, "\x1b[H%s%s\x1b[K\n\x1b[J", rendered, p.status(number, delay, paused))
+			return err
+		}
+		if err := draw(); err != nil {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+	wait:
+		for {
+			var tick <-chan time.Time
+			if !paused {
+				tick = timer.C
+			}
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-tick:
+				break wait
+			case key := <-p.Keys:
+				switch key {
+				case ' ':
+					paused = !paused
+					timer.Reset(delay)
+				case 'n':
+					if paused {
+						break wait
+					}
+				case '+':
+					delay = max(delay/2, minDelay)
+				case '-':
+					delay = min(delay*2, maxDelay)
+				case 'q':
+					timer.Stop()
+					return nil
+				}
+				if err := draw(); err != nil {
+					return err
+				}
+			}
+		}
+		timer.Stop()
+	}
+	return nil
+}
+
+// status returns the line shown beneath each frame.
+func (p *Player) status(number int, delay time.Duration, paused bool) string {
+	status := fmt.Sprintf("frame %d", number)
+	if p.Keys == nil {
+		return status
+	}
+	if paused {
+		status += " (paused)"
+	}
+	return status + fmt.Sprintf(", %s per frame; space: pause, n: step, +/-: speed, q: quit", delay)
+}
@@ -0,0 +1,61 @@
+// Package checked does integer arithmetic that reports overflow instead of silently wrapping around, so that a
+// solution can tell when an answer has outgrown int and needs math/big.
+package checked
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrOverflow is returned when the result of an operation does not fit in an int.
+var ErrOverflow = errors.New("integer overflow")
+
+// Add returns a + b.
+func Add(a, b int) (int, error) {
+	sum := a + b
+	// The sum has wrapped around if both operands have the same sign and the sum has the other.
+	if (a >= 0) == (b >= 0) && (sum >= 0) != (a >= 0) {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+// Sub returns a - b.
+func Sub(a, b int) (int, error) {
+	difference := a - b
+	// The difference has wrapped around if the operands have different signs and the difference has the sign of b.
+	if (a >= 0) != (b >= 0) && (difference >= 0) != (a >= 0) {
+		return 0, ErrOverflow
+	}
+	return difference, nil
+}
+
+// Mul returns a * b.
+func Mul(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, ErrOverflow
+	}
+	return product, nil
+}
+
+// Concat returns the number written as the digits of a followed by the digits of b, where b is not negative.
+func Concat(a, b int) (int, error) {
+	shifted := a
+	for rest := b; ; rest /= 10 {
+		var err error
+		if shifted, err = Mul(shifted, 10); err != nil {
+			return 0, err
+		}
+		if rest < 10 {
+			break
+		}
+	}
+	if a < 0 {
+		return Add(shifted, -b)
+	}
+	return Add(shifted, b)
+}
@@ -0,0 +1,46 @@
+package checked
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		f        func(a, b int) (int, error)
+		a, b     int
+		want     int
+		overflow bool
+	}{
+		{"Add", Add, 2, 3, 5, false},
+		{"Add", Add, math.MaxInt, -1, math.MaxInt - 1, false},
+		{"Add", Add, math.MaxInt, 1, 0, true},
+		{"Add", Add, math.MinInt, -1, 0, true},
+		{"Sub", Sub, 2, 3, -1, false},
+		{"Sub", Sub, -1, math.MaxInt, math.MinInt, false},
+		{"Sub", Sub, 0, math.MinInt, 0, true},
+		{"Sub", Sub, math.MaxInt, -1, 0, true},
+		{"Mul", Mul, -4, 5, -20, false},
+		{"Mul", Mul, 0, math.MinInt, 0, false},
+		{"Mul", Mul, math.MaxInt/2 + 1, 2, 0, true},
+		{"Mul", Mul, math.MinInt, -1, 0, true},
+		{"Mul", Mul, -1, math.MinInt, 0, true},
+		{"Concat", Concat, 12, 345, 12345, false},
+		{"Concat", Concat, 7, 0, 70, false},
+		{"Concat", Concat, -12, 3, -123, false},
+		{"Concat", Concat, 922337203685477580, 7, math.MaxInt, false},
+		{"Concat", Concat, 922337203685477580, 8, 0, true},
+		{"Concat", Concat, 1, math.MaxInt, 0, true},
+	} {
+		got, err := test.f(test.a, test.b)
+		if test.overflow {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%s(%d, %d) = %d, %v, want %v", test.name, test.a, test.b, got, err, ErrOverflow)
+			}
+		} else if err != nil || got != test.want {
+			t.Errorf("%s(%d, %d) = %d, %v, want %d", test.name, test.a, test.b, got, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+// benchmark is the measured cost of solving one part of a day.
+type benchmark struct {
+	Day         int   `json:"day"`
+	Part        int   `json:"part"`
+	Iterations  int   `json:"iterations"`
+	NsPerOp     int64 `json:"nsPerOp"`
+	AllocsPerOp int64 `json:"allocsPerOp"`
+	BytesPerOp  int64 `json:"bytesPerOp"`
+}
+
+// benchCommand benchmarks the solutions and optionally compares them against a saved baseline. It fails if any part
+// cannot be solved, or if any part is slower than the baseline by more than the threshold.
+func benchCommand(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	part := flags.Int("part", 0, "benchmark only the given part (1 or 2)")
+	benchTime := flags.String("benchtime", "1s", "minimum time to spend on each part, or a fixed count such as 5x")
+	sortBy := flags.String("sort", "day", "column to sort by: day, time, allocs or bytes")
+	save := flags.String("save", "", "write the results to a baseline JSON file")
+	baselineFile := flags.String("baseline", "", "compare the results against a baseline JSON file")
+	threshold := flags.Float64("threshold", 10, "percentage increase in time per op that counts as a regression")
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc bench [day|all] [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+
+	selected, err := selectDays(*yearNumber, positional)
+	if err != nil {
+		return err
+	}
+	params, err := paramOptions.load(*yearNumber, selected)
+	if err != nil {
+		return err
+	}
+
+	var baseline []benchmark
+	if *baselineFile != "" {
+		if baseline, err = readBenchmarks(*baselineFile); err != nil {
+			return err
+		}
+	}
+
+	testing.Init()
+	if err := flag.Set("test.benchtime", *benchTime); err != nil {
+		return fmt.Errorf("invalid benchtime %q: %w", *benchTime, err)
+	}
+
+	results := []benchmark{}
+	failures, total := 0, 0
+	for _, d := range selected {
+		input, err := os.ReadFile(d.inputPath())
+		if err != nil {
+			return err
+		}
+		for _, p := range parts(d, *part) {
+			fmt.Fprintf(os.Stderr, "benchmarking day %d part %d\n", d.Number, p)
+			total++
+			result, err := runBenchmark(d, p, input, params(d))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "day %d part %d: %v\n", d.Number, p, err)
+				failures++
+				continue
+			}
+			results = append(results, result)
+		}
+	}
+
+	if err := sortBenchmarks(results, *sortBy); err != nil {
+		return err
+	}
+	regressions, err := printBenchmarks(results, baseline, *threshold)
+	if err != nil {
+		return err
+	}
+	if failures > 0 {
+		// A baseline missing the parts that failed would report them as new in every later comparison.
+		return fmt.Errorf("%d of %d parts failed", failures, total)
+	}
+	if *save != "" {
+		if err := writeBenchmarks(*save, results); err != nil {
+			return err
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d of %d parts are more than %g%% slower than the baseline", regressions, len(results),
+			*threshold)
+	}
+	return nil
+}
+
+// runBenchmark benchmarks a single part of a day with the given parameters, normalizing the line endings of the input
+// as aoc run does. The part is solved once before it is benchmarked, so that an error is returned rather than failing
+// the benchmark, which would only leave it with no measurements.
+func runBenchmark(d day, part int, input []byte, params map[string]int) (benchmark, error) {
+	input = parse.Normalize(input)
+	newSolver := func() solver.Solver {
+		s := d.New()
+		// The parameters were checked when the part was first solved.
+		_ = solver.SetParams(s, params)
+		return s
+	}
+	err := recoverPanic(func() error {
+		s := d.New()
+		if err := solver.SetParams(s, params); err != nil {
+			return err
+		}
+		if err := s.Parse(bytes.NewReader(input)); err != nil {
+			return err
+		}
+		_, err := solver.Part(context.Background(), s, part)
+		return err
+	})
+	if err != nil {
+		return benchmark{}, err
+	}
+
+	result := testing.Benchmark(func(b *testing.B) {
+		solvertest.BenchmarkBytes(b, newSolver, part, input)
+	})
+	if result.N == 0 {
+		return benchmark{}, errors.New("the benchmark failed")
+	}
+	return benchmark{
+		Day:         d.Number,
+		Part:        part,
+		Iterations:  result.N,
+		NsPerOp:     result.NsPerOp(),
+		AllocsPerOp: result.AllocsPerOp(),
+		BytesPerOp:  result.AllocedBytesPerOp(),
+	}, nil
+}
+
+// sortBenchmarks sorts the results by the named column, putting the most expensive parts first.
+func sortBenchmarks(results []benchmark, column string) error {
+	var key func(benchmark) int64
+	switch column {
+	case "day":
+		slices.SortFunc(results, func(a, b benchmark) int {
+			return (a.Day*10 + a.Part) - (b.Day*10 + b.Part)
+		})
+		return nil
+	case "time":
+		key = func(b benchmark) int64 { return b.NsPerOp }
+	case "allocs":
+		key = func(b benchmark) int64 { return b.AllocsPerOp }
+	case "bytes":
+		key = func(b benchmark) int64 { return b.BytesPerOp }
+	default:
+		return fmt.Errorf("cannot sort by %q", column)
+	}
+	slices.SortStableFunc(results, func(a, b benchmark) int {
+		switch {
+		case key(a) > key(b):
+			return -1
+		case key(a) < key(b):
+			return 1
+		}
+		return 0
+	})
+	return nil
+}
+
+// printBenchmarks prints the results as a table, along with the change from the baseline if there is one, and returns
+// the number of parts whose time per op grew by more than the threshold percentage.
+func printBenchmarks(results, baseline []benchmark, threshold float64) (int, error) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	if baseline == nil {
+		fmt.Fprintln(writer, "DAY\tPART\tTIME/OP\tALLOCS/OP\tBYTES/OP\t")
+	} else {
+		fmt.Fprintln(writer, "DAY\tPART\tTIME/OP\tDELTA\tALLOCS/OP\tDELTA\tBYTES/OP\tDELTA\t\t")
+	}
+
+	regressions := 0
+	for _, result := range results {
+		row := fmt.Sprintf("%d\t%d\t%s\t", result.Day, result.Part, formatDuration(time.Duration(result.NsPerOp)))
+		if baseline == nil {
+			row += fmt.Sprintf("%d\t%d\t", result.AllocsPerOp, result.BytesPerOp)
+			fmt.Fprintln(writer, row)
+			continue
+		}
+
+		previous, ok := findBenchmark(baseline, result.Day, result.Part)
+		if !ok {
+			row += fmt.Sprintf("new\t%d\t\t%d\t\t\t", result.AllocsPerOp, result.BytesPerOp)
+			fmt.Fprintln(writer, row)
+			continue
+		}
+		timeChange := percentChange(previous.NsPerOp, result.NsPerOp)
+		row += fmt.Sprintf("%s\t%d\t%s\t%d\t%s\t", formatChange(timeChange), result.AllocsPerOp,
+			formatChange(percentChange(previous.AllocsPerOp, result.AllocsPerOp)), result.BytesPerOp,
+			formatChange(percentChange(previous.BytesPerOp, result.BytesPerOp)))
+		if timeChange > threshold {
+			row += "slower\t"
+			regressions++
+		} else {
+			row += "\t"
+		}
+		fmt.Fprintln(writer, row)
+	}
+	if err := writer.Flush(); err != nil {
+		return 0, err
+	}
+	return regressions, nil
+}
+
+// findBenchmark finds the result for a part of a day.
+func findBenchmark(results []benchmark, day, part int) (benchmark, bool) {
+	for _, result := range results {
+		if result.Day == day && result.Part == part {
+			return result, true
+		}
+	}
+	return benchmark{}, false
+}
+
+// percentChange calculates the percentage change from an old value to a new one.
+func percentChange(old, new int64) float64 {
+	if old == 0 {
+		if new == 0 {
+			return 0
+		}
+		return 100
+	}
+	return float64(new-old) / float64(old) * 100
+}
+
+// formatChange formats a percentage change for display.
+func formatChange(change float64) string {
+	return fmt.Sprintf("%+.1f%%", change)
+}
+
+// readBenchmarks reads results from a baseline JSON file.
+func readBenchmarks(fileName string) ([]benchmark, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []benchmark{}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("reading baseline %s: %w", fileName, err)
+	}
+	if len(results) == 0 {
+		return nil, errors.New("baseline has no results")
+	}
+	return results, nil
+}
+
+// writeBenchmarks writes results to a baseline JSON file.
+func writeBenchmarks(fileName string, results []benchmark) error {
+	data, err := json.MarshalIndent(results, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, append(data, '\n'), 0o644)
+}
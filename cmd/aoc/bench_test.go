@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestRunBenchmarkReportsErrors(t *testing.T) {
+	previous := flag.Lookup("test.benchtime").Value.String()
+	if err := flag.Set("test.benchtime", "1x"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { flag.Set("test.benchtime", previous) })
+	d, err := findDay(2024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runBenchmark(d, 1, []byte("not a list\n"), nil); err == nil {
+		t.Error("benchmarked a part whose input could not be parsed")
+	}
+	if _, err := runBenchmark(d, 1, []byte("3   4\r\n4   3\r\n\r\n"), nil); err != nil {
+		t.Errorf("input with CRLF line endings and a blank line at the end: %v", err)
+	}
+
+	d, err = findDay(2024, 18)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = runBenchmark(d, 1, []byte("5,4\n"), map[string]int{"gridSize": 100000})
+	if err == nil || !strings.Contains(err.Error(), "gridSize") {
+		t.Errorf("got error %v, want the parameter to be rejected", err)
+	}
+}
+
+func TestPrintBenchmarksCountsRegressions(t *testing.T) {
+	baseline := []benchmark{{Day: 1, Part: 1, NsPerOp: 100}, {Day: 1, Part: 2, NsPerOp: 100}}
+	results := []benchmark{{Day: 1, Part: 1, NsPerOp: 105}, {Day: 1, Part: 2, NsPerOp: 150}, {Day: 2, Part: 1, NsPerOp: 1}}
+	regressions, err := printBenchmarks(results, baseline, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regressions != 1 {
+		t.Errorf("got %d regressions, want 1", regressions)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	"github.com/markcooper37/aoc-2024/internal/cache"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// cacheCommand manages the cache of answers. Its only subcommand is prune.
+func cacheCommand(args []string) error {
+	flags := flag.NewFlagSet("cache", flag.ContinueOnError)
+	cacheDir := flags.String("cache", defaultCacheDir(), "directory that answers are cached in")
+	all := flags.Bool("all", false, "remove every cached answer, not just those that can no longer be used")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc cache prune [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 || positional[0] != "prune" {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+
+	dir := filepath.Join(*cacheDir, "results")
+	removed, err := cache.New(dir).Prune(func(key cache.Key) bool {
+		return !*all && currentKey(key)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("removed %d files from %s\n", removed, dir)
+	return nil
+}
+
+// currentKey reports whether a cached answer could still be used: its day still has a solution and the solver's
+// version has not changed since the answer was cached.
+func currentKey(key cache.Key) bool {
+	d, err := findDay(key.Year, key.Day)
+	if err != nil {
+		return false
+	}
+	versioned, ok := d.New().(solver.Versioned)
+	return ok && versioned.Version() == key.Version
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/internal/cache"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+func TestSolveDaysCache(t *testing.T) {
+	cacheDir := t.TempDir()
+	answers := resultCache(cacheDir, false)
+	d, err := findDay(2024, 18)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputPath := inputPaths("../../day-18/test_data.txt")
+	params := func(day) map[string]int { return map[string]int{"gridSize": 6, "simulatedBytes": 12} }
+
+	first := solveDays([]day{d}, 0, inputPath, params, time.Minute, 1, false, answers)
+	second := solveDays([]day{d}, 0, inputPath, params, time.Minute, 1, false, answers)
+	for i := range first {
+		if first[i].Err != nil || first[i].Cached {
+			t.Errorf("first run of part %d: got error %v, cached %t", first[i].Part, first[i].Err, first[i].Cached)
+		}
+		if !second[i].Cached || second[i].Answer != first[i].Answer {
+			t.Errorf("second run of part %d: got %q, cached %t, want %q from the cache", second[i].Part,
+				second[i].Answer, second[i].Cached, first[i].Answer)
+		}
+	}
+
+	fewerBytes := func(day) map[string]int { return map[string]int{"gridSize": 6, "simulatedBytes": 10} }
+	if r := solveDays([]day{d}, 1, inputPath, fewerBytes, time.Minute, 1, false, answers)[0]; r.Cached {
+		t.Error("got a cached answer for different parameters")
+	}
+	if r := solveDays([]day{d}, 1, inputPath, params, time.Minute, 1, false, nil)[0]; r.Cached {
+		t.Error("got a cached answer with caching turned off")
+	}
+
+	stale, ok := cacheKey(d, d.New(), 1, first[0].InputHash, false)
+	if !ok {
+		t.Fatal("day 18 has no version")
+	}
+	stale.Version += "-old"
+	if err := answers.Put(stale, solver.IntAnswer(1)); err != nil {
+		t.Fatal(err)
+	}
+	removed, err := cache.New(filepath.Join(cacheDir, "results")).Prune(currentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 {
+		t.Errorf("pruned %d answers, want only the one from an old version", removed)
+	}
+	if err := cacheCommand([]string{"prune", "--cache", cacheDir, "--all"}); err != nil {
+		t.Fatal(err)
+	}
+	if r := solveDays([]day{d}, 1, inputPath, params, time.Minute, 1, false, answers)[0]; r.Cached {
+		t.Error("got a cached answer after removing them all")
+	}
+}
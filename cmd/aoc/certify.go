@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// certifyCommand solves the parts of a day along with witnesses for their answers, and checks each witness against
+// the input with the day's independent verifier.
+func certifyCommand(args []string) error {
+	flags := flag.NewFlagSet("certify", flag.ContinueOnError)
+	part := flags.Int("part", 0, "certify only the given part (1 or 2)")
+	input := flags.String("input", "", "path to the puzzle input (defaults to the day's input file)")
+	yearNumber := addYearFlag(flags)
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc certify <day> [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+	number, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid day %q", positional[0])
+	}
+	d, err := findDay(*yearNumber, number)
+	if err != nil {
+		return err
+	}
+	if *part > d.Parts {
+		return fmt.Errorf("day %d: %w", d.Number, solver.ErrNoPart)
+	}
+
+	params, err := paramOptions.load(d.Year, []day{d})
+	if err != nil {
+		return err
+	}
+	return certify(os.Stdout, d, parts(d, *part), inputPaths(*input)(d), params(d))
+}
+
+// certify prints the answer and witness for each part, followed by whether the witness verified. The witness is
+// checked by a second solver that has only parsed the input, so that nothing left over from solving can help it.
+func certify(w io.Writer, d day, parts []int, fileName string, params map[string]int) error {
+	newSolver := func() (solver.Certifier, error) {
+		s := d.New()
+		certifier, ok := s.(solver.Certifier)
+		if !ok {
+			return nil, fmt.Errorf("day %d cannot certify its answers", d.Number)
+		}
+		if err := solver.SetParams(s, params); err != nil {
+			return nil, err
+		}
+		if _, err := parseFile(s, fileName); err != nil {
+			return nil, err
+		}
+		return certifier, nil
+	}
+	certifier, err := newSolver()
+	if err != nil {
+		return err
+	}
+	verifier, err := newSolver()
+	if err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		ctx, cancel := context.WithTimeout(context.Background(), d.budget())
+		answer, witness, err := certifier.Certify(ctx, part)
+		cancel()
+		if errors.Is(err, solver.ErrNoWitness) {
+			fmt.Fprintf(w, "part %d: no witness\n", part)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("day %d part %d: %w", d.Number, part, err)
+		}
+
+		fmt.Fprintf(w, "part %d: %s\n%s\n", part, formatAnswer(answer), witness)
+		if err := verifier.Verify(part, answer, witness); err != nil {
+			return fmt.Errorf("day %d part %d: witness does not verify: %w", d.Number, part, err)
+		}
+		fmt.Fprintln(w, "verified")
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// defaultConfigFile is the configuration file read from the current directory if no other is given.
+const defaultConfigFile = "aoc.json"
+
+// config is the contents of a configuration file. The days at the top level are days of 2024, which was the only year
+// when the format was chosen, and the days of any year can be given under "years":
+//
+//	{"days": {"18": {"params": {"gridSize": 6, "simulatedBytes": 12}}}}
+//	{"years": {"2024": {"days": {"18": {"params": {"gridSize": 6, "simulatedBytes": 12}}}}}}
+type config struct {
+	Days  map[int]dayConfig  `json:"days"`
+	Years map[int]yearConfig `json:"years"`
+}
+
+// topLevelYear is the year of the days at the top level of a configuration file.
+const topLevelYear = 2024
+
+// yearConfig is the configuration for the days of a single year.
+type yearConfig struct {
+	Days map[int]dayConfig `json:"days"`
+}
+
+// dayConfig is the configuration for a single day.
+type dayConfig struct {
+	Params map[string]int `json:"params"`
+}
+
+// readConfig reads a configuration file. A missing file is only an error if it was asked for by name.
+func readConfig(fileName string, required bool) (config, error) {
+	data, err := os.ReadFile(fileName)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return config{}, nil
+	} else if err != nil {
+		return config{}, err
+	}
+
+	c := config{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return config{}, fmt.Errorf("reading config %s: %w", fileName, err)
+	}
+	if len(c.Days) > 0 && len(c.Years[topLevelYear].Days) > 0 {
+		return config{}, fmt.Errorf("reading config %s: days of %d are given both at the top level and under years",
+			fileName, topLevelYear)
+	}
+	if err := c.check(); err != nil {
+		return config{}, fmt.Errorf("reading config %s: %w", fileName, err)
+	}
+	return c, nil
+}
+
+// check returns an error if the configuration names a day or parameter that does not exist.
+func (c config) check() error {
+	yearNumbers := []int{topLevelYear}
+	for yearNumber := range c.Years {
+		yearNumbers = append(yearNumbers, yearNumber)
+	}
+	for _, yearNumber := range yearNumbers {
+		for number, dc := range c.days(yearNumber) {
+			d, err := findDay(yearNumber, number)
+			if err != nil {
+				return err
+			}
+			for name := range dc.Params {
+				if err := checkParam(d, name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// days returns the configuration for the days of a year.
+func (c config) days(yearNumber int) map[int]dayConfig {
+	if yearNumber == topLevelYear && len(c.Days) > 0 {
+		return c.Days
+	}
+	return c.Years[yearNumber].Days
+}
+
+// paramOverride is a parameter value given on the command line.
+type paramOverride struct {
+	Day   int // the day the parameter is for, or 0 for the day being run
+	Name  string
+	Value int
+}
+
+// paramFlags are the flags for setting the parameters of the solvers.
+type paramFlags struct {
+	configFile *string
+	overrides  []paramOverride
+}
+
+// addParamFlags defines the flags for setting parameters on a flag set.
+func addParamFlags(flags *flag.FlagSet) *paramFlags {
+	p := &paramFlags{
+		configFile: flags.String("config", "", "JSON file of parameters for each day (default "+defaultConfigFile+" if it exists)"),
+	}
+	flags.Func("param", "set a parameter as `[day.]name=value`, overriding the config file (repeatable)",
+		func(value string) error {
+			override, err := parseParamOverride(value)
+			if err != nil {
+				return err
+			}
+			p.overrides = append(p.overrides, override)
+			return nil
+		})
+	return p
+}
+
+// parseParamOverride parses a parameter given as [day.]name=value.
+func parseParamOverride(text string) (paramOverride, error) {
+	name, valueStr, ok := strings.Cut(text, "=")
+	if !ok {
+		return paramOverride{}, fmt.Errorf("parameter %q is not of the form [day.]name=value", text)
+	}
+	override := paramOverride{Name: name}
+	if dayStr, paramName, ok := strings.Cut(name, "."); ok {
+		number, err := strconv.Atoi(dayStr)
+		if err != nil {
+			return paramOverride{}, fmt.Errorf("invalid day %q in parameter %q", dayStr, text)
+		}
+		override.Day, override.Name = number, paramName
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		return paramOverride{}, fmt.Errorf("invalid value %q for parameter %s", valueStr, name)
+	}
+	override.Value = value
+	return override, nil
+}
+
+// load reads the configuration file and checks the command-line parameters against the days of a year being run.
+// Parameters without a day apply to the only day being run.
+func (p *paramFlags) load(yearNumber int, selected []day) (func(day) map[string]int, error) {
+	fileName, required := *p.configFile, true
+	if fileName == "" {
+		fileName, required = defaultConfigFile, false
+	}
+	c, err := readConfig(fileName, required)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := map[int]map[string]int{}
+	for _, override := range p.overrides {
+		number := override.Day
+		if number == 0 {
+			if len(selected) != 1 {
+				return nil, fmt.Errorf("parameter %s must name its day, as in 18.%s, when running several days",
+					override.Name, override.Name)
+			}
+			number = selected[0].Number
+		}
+		d, err := findDay(yearNumber, number)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkParam(d, override.Name); err != nil {
+			return nil, err
+		}
+		if overrides[number] == nil {
+			overrides[number] = map[string]int{}
+		}
+		overrides[number][override.Name] = override.Value
+	}
+
+	return func(d day) map[string]int {
+		params := map[string]int{}
+		for name, value := range c.days(d.Year)[d.Number].Params {
+			params[name] = value
+		}
+		for name, value := range overrides[d.Number] {
+			params[name] = value
+		}
+		return params
+	}, nil
+}
+
+// checkParam returns an error if a day has no parameter with the given name.
+func checkParam(d day, name string) error {
+	return checkParamOf(fmt.Sprintf("day %d", d.Number), d.New(), name)
+}
+
+// checkParamOf returns an error if a solver or generator, described by owner in the error, has no parameter with the
+// given name.
+func checkParamOf(owner string, s any, name string) error {
+	names := []string{}
+	for _, param := range solver.Params(s) {
+		if param.Name == name {
+			return nil
+		}
+		names = append(names, param.Name)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("%s has no parameters, so cannot set %q", owner, name)
+	}
+	return fmt.Errorf("%s has no parameter %q (it has %s)", owner, name, strings.Join(names, ", "))
+}
+
+// paramsCommand lists the parameters of each day along with their values after applying the configuration file.
+func paramsCommand(args []string) error {
+	flags := flag.NewFlagSet("params", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc params [day|all] [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	selected, err := selectDays(*yearNumber, positional)
+	if err != nil {
+		return err
+	}
+	params, err := paramOptions.load(*yearNumber, selected)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "DAY\tPARAMETER\tDEFAULT\tVALUE")
+	for _, d := range selected {
+		overrides := params(d)
+		for _, param := range solver.Params(d.New()) {
+			value := param.Value
+			if override, ok := overrides[param.Name]; ok {
+				value = override
+			}
+			fmt.Fprintf(writer, "%d\t%s\t%d\t%d\n", d.Number, param.Name, param.Value, value)
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParamFlags(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "aoc.json")
+	data := `{"days": {"18": {"params": {"gridSize": 6, "simulatedBytes": 12}}, "20": {"params": {"cheatRadius": 2}}}}`
+	if err := os.WriteFile(fileName, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	paramOptions := addParamFlags(flags)
+	if err := flags.Parse([]string{"--config", fileName, "--param", "18.simulatedBytes=20", "--param", "11.partTwoBlinks=30"}); err != nil {
+		t.Fatal(err)
+	}
+	params, err := paramOptions.load(2024, days2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[int]map[string]int{
+		18: {"gridSize": 6, "simulatedBytes": 20},
+		20: {"cheatRadius": 2},
+		11: {"partTwoBlinks": 30},
+		1:  {},
+	}
+	for number, want := range tests {
+		d, err := findDay(2024, number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := params(d); !maps.Equal(got, want) {
+			t.Errorf("day %d: got %v, want %v", number, got, want)
+		}
+	}
+}
+
+func TestConfigYears(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "aoc.json")
+	data := `{"years": {"2024": {"days": {"18": {"params": {"gridSize": 6}}}}}}`
+	if err := os.WriteFile(fileName, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := readConfig(fileName, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.days(2024)[18].Params; !maps.Equal(got, map[string]int{"gridSize": 6}) {
+		t.Errorf("got parameters %v for day 18 of 2024, want gridSize 6", got)
+	}
+}
+
+func TestParamErrors(t *testing.T) {
+	dir := t.TempDir()
+	badConfig := filepath.Join(dir, "bad.json")
+	if err := os.WriteFile(badConfig, []byte(`{"days": {"18": {"params": {"gridsize": 6}}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	unknownYear := filepath.Join(dir, "unknown-year.json")
+	if err := os.WriteFile(unknownYear, []byte(`{"years": {"2019": {"days": {"1": {}}}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	bothPlaces := filepath.Join(dir, "both.json")
+	data := `{"days": {"18": {}}, "years": {"2024": {"days": {"18": {}}}}}`
+	if err := os.WriteFile(bothPlaces, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	day18, err := findDay(2024, 18)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args     []string
+		selected []day
+		want     string
+	}{
+		{[]string{"--config", badConfig}, days2024, `day 18 has no parameter "gridsize" (it has gridSize, simulatedBytes)`},
+		{[]string{"--config", filepath.Join(dir, "missing.json")}, days2024, "no such file"},
+		{[]string{"--config", unknownYear}, days2024, "no solutions for 2019"},
+		{[]string{"--config", bothPlaces}, days2024, "given both at the top level and under years"},
+		{[]string{"--param", "gridSize=6"}, days2024, "must name its day"},
+		{[]string{"--param", "5.gridSize=6"}, days2024, "day 5 has no parameters"},
+		{[]string{"--param", "gridSize=6"}, []day{day18}, ""},
+	}
+	for _, test := range tests {
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		paramOptions := addParamFlags(flags)
+		if err := flags.Parse(test.args); err != nil {
+			t.Fatal(err)
+		}
+		_, err := paramOptions.load(2024, test.selected)
+		if test.want == "" && err != nil {
+			t.Errorf("%v: got error %v", test.args, err)
+		} else if test.want != "" && (err == nil || !strings.Contains(err.Error(), test.want)) {
+			t.Errorf("%v: got error %v, want one containing %q", test.args, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/day-01"
+	"github.com/markcooper37/aoc-2024/day-02"
+	"github.com/markcooper37/aoc-2024/day-03"
+	"github.com/markcooper37/aoc-2024/day-04"
+	"github.com/markcooper37/aoc-2024/day-05"
+	"github.com/markcooper37/aoc-2024/day-06"
+	"github.com/markcooper37/aoc-2024/day-07"
+	"github.com/markcooper37/aoc-2024/day-08"
+	"github.com/markcooper37/aoc-2024/day-09"
+	"github.com/markcooper37/aoc-2024/day-10"
+	"github.com/markcooper37/aoc-2024/day-11"
+	"github.com/markcooper37/aoc-2024/day-12"
+	"github.com/markcooper37/aoc-2024/day-13"
+	"github.com/markcooper37/aoc-2024/day-14"
+	"github.com/markcooper37/aoc-2024/day-15"
+	"github.com/markcooper37/aoc-2024/day-16"
+	"github.com/markcooper37/aoc-2024/day-17"
+	"github.com/markcooper37/aoc-2024/day-18"
+	"github.com/markcooper37/aoc-2024/day-19"
+	"github.com/markcooper37/aoc-2024/day-20"
+	"github.com/markcooper37/aoc-2024/day-21"
+	"github.com/markcooper37/aoc-2024/day-22"
+	"github.com/markcooper37/aoc-2024/day-23"
+	"github.com/markcooper37/aoc-2024/day-24"
+	"github.com/markcooper37/aoc-2024/day-25"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// day describes the solution for a single day of the puzzle.
+type day struct {
+	Year         int // filled in when the day's year is registered
+	Number       int
+	Input        string // default input file within the day's directory
+	Parts        int
+	New          func() solver.Solver
+	NewGenerator func() solver.Generator // creates random inputs for the day
+	Budget       time.Duration           // time allowed for each part, or defaultBudget if zero
+}
+
+// defaultBudget is the time allowed for each part of a day that does not set its own budget.
+const defaultBudget = 30 * time.Second
+
+// year holds the solutions for the days of one year.
+type year struct {
+	Number int
+	Dir    string // directory containing the year's day directories, relative to the repository root
+	Days   []day
+}
+
+// years lists every year with solutions, oldest first. Years after 2024 keep their days in a directory named after
+// the year, and are registered by a file that aoc new generates.
+var years = []year{}
+
+func init() {
+	registerYear(2024, ".", days2024)
+}
+
+// days2024 lists the solutions for every day of 2024, which live at the root of the repository.
+var days2024 = []day{
+	{Number: 1, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day01.New() },
+		NewGenerator: func() solver.Generator { return day01.NewGenerator() }},
+	{Number: 2, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day02.New() },
+		NewGenerator: func() solver.Generator { return day02.NewGenerator() }},
+	{Number: 3, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day03.New() },
+		NewGenerator: func() solver.Generator { return day03.NewGenerator() }},
+	{Number: 4, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day04.New() },
+		NewGenerator: func() solver.Generator { return day04.NewGenerator() }},
+	{Number: 5, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day05.New() },
+		NewGenerator: func() solver.Generator { return day05.NewGenerator() }},
+	{Number: 6, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day06.New() },
+		NewGenerator: func() solver.Generator { return day06.NewGenerator() }, Budget: 2 * time.Minute},
+	{Number: 7, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day07.New() },
+		NewGenerator: func() solver.Generator { return day07.NewGenerator() }},
+	{Number: 8, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day08.New() },
+		NewGenerator: func() solver.Generator { return day08.NewGenerator() }},
+	{Number: 9, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day09.New() },
+		NewGenerator: func() solver.Generator { return day09.NewGenerator() }},
+	{Number: 10, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day10.New() },
+		NewGenerator: func() solver.Generator { return day10.NewGenerator() }},
+	{Number: 11, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day11.New() },
+		NewGenerator: func() solver.Generator { return day11.NewGenerator() }},
+	{Number: 12, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day12.New() },
+		NewGenerator: func() solver.Generator { return day12.NewGenerator() }},
+	{Number: 13, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day13.New() },
+		NewGenerator: func() solver.Generator { return day13.NewGenerator() }},
+	{Number: 14, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day14.New() },
+		NewGenerator: func() solver.Generator { return day14.NewGenerator() }},
+	{Number: 15, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day15.New() },
+		NewGenerator: func() solver.Generator { return day15.NewGenerator() }},
+	{Number: 16, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day16.New() },
+		NewGenerator: func() solver.Generator { return day16.NewGenerator() }, Budget: time.Minute},
+	{Number: 17, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day17.New() },
+		NewGenerator: func() solver.Generator { return day17.NewGenerator() }},
+	{Number: 18, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day18.New() },
+		NewGenerator: func() solver.Generator { return day18.NewGenerator() }},
+	{Number: 19, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day19.New() },
+		NewGenerator: func() solver.Generator { return day19.NewGenerator() }},
+	{Number: 20, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day20.New() },
+		NewGenerator: func() solver.Generator { return day20.NewGenerator() }, Budget: 2 * time.Minute},
+	{Number: 21, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day21.New() },
+		NewGenerator: func() solver.Generator { return day21.NewGenerator() }},
+	{Number: 22, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day22.New() },
+		NewGenerator: func() solver.Generator { return day22.NewGenerator() }},
+	{Number: 23, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day23.New() },
+		NewGenerator: func() solver.Generator { return day23.NewGenerator() }},
+	{Number: 24, Input: "input.txt", Parts: 2, New: func() solver.Solver { return day24.New() },
+		NewGenerator: func() solver.Generator { return day24.NewGenerator() }},
+	{Number: 25, Input: "input.txt", Parts: 1, New: func() solver.Solver { return day25.New() },
+		NewGenerator: func() solver.Generator { return day25.NewGenerator() }},
+}
+
+// registerYear adds a year and its days to years.
+func registerYear(number int, dir string, days []day) {
+	for i := range days {
+		days[i].Year = number
+	}
+	years = append(years, year{Number: number, Dir: dir, Days: days})
+	slices.SortFunc(years, func(a, b year) int { return a.Number - b.Number })
+}
+
+// findYear finds the year with the given number.
+func findYear(number int) (year, error) {
+	for _, y := range years {
+		if y.Number == number {
+			return y, nil
+		}
+	}
+	return year{}, fmt.Errorf("no solutions for %d", number)
+}
+
+// latestYear returns the number of the most recent year with solutions.
+func latestYear() int {
+	return years[len(years)-1].Number
+}
+
+// yearDir returns the directory containing a year's day directories. A year without solutions yet gets a directory
+// named after it.
+func yearDir(number int) string {
+	if y, err := findYear(number); err == nil {
+		return y.Dir
+	}
+	return strconv.Itoa(number)
+}
+
+// addYearFlag defines the flag that chooses the year on a flag set.
+func addYearFlag(flags *flag.FlagSet) *int {
+	return flags.Int("year", latestYear(), "year of the puzzles")
+}
+
+// dir returns the directory containing the day's solution.
+func (d day) dir() string {
+	return filepath.Join(yearDir(d.Year), fmt.Sprintf("day-%02d", d.Number))
+}
+
+// inputPath returns the path to the day's default input file.
+func (d day) inputPath() string {
+	return filepath.Join(d.dir(), d.Input)
+}
+
+// budget returns the time allowed for each part of the day.
+func (d day) budget() time.Duration {
+	if d.Budget == 0 {
+		return defaultBudget
+	}
+	return d.Budget
+}
+
+// findDay finds the day with the given number in a year.
+func findDay(yearNumber, number int) (day, error) {
+	y, err := findYear(yearNumber)
+	if err != nil {
+		return day{}, err
+	}
+	for _, d := range y.Days {
+		if d.Number == number {
+			return d, nil
+		}
+	}
+	return day{}, fmt.Errorf("no solution for day %d of %d", number, yearNumber)
+}
+
+// selectDays returns the days of a year named by an optional argument, which is either a day number or "all". No
+// argument means all days.
+func selectDays(yearNumber int, positional []string) ([]day, error) {
+	if len(positional) == 0 || positional[0] == "all" {
+		y, err := findYear(yearNumber)
+		if err != nil {
+			return nil, err
+		}
+		return y.Days, nil
+	}
+	number, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", positional[0])
+	}
+	d, err := findDay(yearNumber, number)
+	if err != nil {
+		return nil, err
+	}
+	return []day{d}, nil
+}
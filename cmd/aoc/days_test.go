@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestMalformedInput(t *testing.T) {
+	for _, d := range days2024 {
+		if d.Number == 3 {
+			// Day 3's input is corrupted memory, so any text is valid.
+			continue
+		}
+		err := d.New().Parse(strings.NewReader("?\n"))
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) {
+			t.Errorf("day %d: got error %v, want a parse error", d.Number, err)
+		} else if parseErr.Line != 1 || parseErr.Text == "" {
+			t.Errorf("day %d: error %q does not point at the offending text", d.Number, err)
+		}
+	}
+}
+
+func TestAnimatedDays(t *testing.T) {
+	animated := []int{6, 14, 15, 16, 18, 20}
+	for _, d := range days2024 {
+		_, ok := d.New().(animate.Animator)
+		if want := slices.Contains(animated, d.Number); ok != want {
+			t.Errorf("day %d: animated is %t, want %t", d.Number, ok, want)
+		}
+	}
+}
+
+func TestCertifiedDays(t *testing.T) {
+	certified := []int{7, 13, 16, 18, 21, 23, 24}
+	for _, d := range days2024 {
+		_, ok := d.New().(solver.Certifier)
+		if want := slices.Contains(certified, d.Number); ok != want {
+			t.Errorf("day %d: certified is %t, want %t", d.Number, ok, want)
+		}
+	}
+}
+
+func TestVersionedDays(t *testing.T) {
+	for _, d := range days2024 {
+		if v, ok := d.New().(solver.Versioned); !ok || v.Version() == "" {
+			t.Errorf("day %d has no version, so its answers cannot be cached", d.Number)
+		}
+	}
+}
+
+// versionsFile records the version of each day along with the answers in its manifest, so that answers cannot change
+// without a new version, which would leave the old answers in the cache.
+const versionsFile = "testdata/versions.json"
+
+var updateVersions = flag.Bool("update-versions", false, "record the versions and answers of the days in "+versionsFile)
+
+// dayVersion is the version of a day and the answers in its manifest, each keyed by the name of its case.
+type dayVersion struct {
+	Version string                `json:"version"`
+	Answers map[string][2]*string `json:"answers"`
+}
+
+func TestVersionsChangeWithAnswers(t *testing.T) {
+	recorded := map[int]dayVersion{}
+	data, err := os.ReadFile(versionsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		t.Fatal(err)
+	}
+
+	current := map[int]dayVersion{}
+	for _, d := range days2024 {
+		cases, err := solvertest.ReadManifest(filepath.Join("../..", d.dir(), solvertest.ManifestFile))
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := dayVersion{Version: d.New().(solver.Versioned).Version(), Answers: map[string][2]*string{}}
+		for _, c := range cases {
+			v.Answers[c.Name()] = [2]*string{c.PartOne, c.PartTwo}
+		}
+		current[d.Number] = v
+
+		previous, ok := recorded[d.Number]
+		if !ok || previous.Version != v.Version {
+			continue
+		}
+		for name, answers := range v.Answers {
+			if old, ok := previous.Answers[name]; ok && !sameAnswers(old, answers) {
+				t.Errorf("day %d: the answers for %s changed without a new version, so cached answers would be "+
+					"returned for it", d.Number, name)
+			}
+		}
+	}
+
+	if *updateVersions {
+		data, err := json.MarshalIndent(current, "", "\t")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(versionsFile, append(data, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if !maps.EqualFunc(recorded, current, func(a, b dayVersion) bool {
+		return a.Version == b.Version && maps.EqualFunc(a.Answers, b.Answers, sameAnswers)
+	}) {
+		t.Errorf("%s is out of date: rerun the test with -update-versions once the version of any day whose answers "+
+			"changed has been raised", versionsFile)
+	}
+}
+
+// sameAnswers reports whether two pairs of expected answers are the same.
+func sameAnswers(a, b [2]*string) bool {
+	for i := range a {
+		if (a[i] == nil) != (b[i] == nil) || (a[i] != nil && *a[i] != *b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCertify(t *testing.T) {
+	d, err := findDay(2024, 23)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer strings.Builder
+	if err := certify(&buffer, d, parts(d, 0), "../../day-23/test_data.txt", nil); err != nil {
+		t.Fatal(err)
+	}
+	want := "part 1: no witness\npart 2: co,de,ka,ta\nco\nde\nka\nta\nverified\n"
+	if got := buffer.String(); got != want {
+		t.Errorf("got output %q, want %q", got, want)
+	}
+
+	d, err = findDay(2024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := certify(&buffer, d, parts(d, 0), "../../day-01/test_data.txt", nil); err == nil {
+		t.Error("certified day 1, which has no witnesses")
+	}
+}
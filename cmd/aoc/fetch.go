@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/internal/client"
+)
+
+// clientFlags are the flags shared by the commands that talk to the Advent of Code site.
+type clientFlags struct {
+	baseURL     *string
+	sessionFile *string
+	cacheDir    *string
+	interval    *time.Duration
+}
+
+// addClientFlags defines the flags for talking to the site on a flag set.
+func addClientFlags(flags *flag.FlagSet) *clientFlags {
+	baseURL := os.Getenv("AOC_BASE_URL")
+	if baseURL == "" {
+		baseURL = client.DefaultBaseURL
+	}
+	configDir, _ := os.UserConfigDir()
+	return &clientFlags{
+		baseURL: flags.String("url", baseURL, "base URL of the site (default from $AOC_BASE_URL)"),
+		sessionFile: flags.String("session-file", filepath.Join(configDir, "aoc", "session"),
+			"file containing the session cookie, used if $AOC_SESSION is not set"),
+		cacheDir: flags.String("cache", defaultCacheDir(), "directory to cache downloads in"),
+		interval: flags.Duration("interval", client.DefaultMinInterval, "minimum time between requests to the site"),
+	}
+}
+
+// client creates a client from the flags, taking the session token from the environment or the session file.
+func (f *clientFlags) client() (*client.Client, error) {
+	session := os.Getenv("AOC_SESSION")
+	if session == "" {
+		data, err := os.ReadFile(*f.sessionFile)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		session = strings.TrimSpace(string(data))
+	}
+
+	c := client.New(*f.baseURL, session, *f.cacheDir)
+	c.MinInterval = *f.interval
+	return c, nil
+}
+
+// explain adds a hint on how to provide a session token to an error caused by not having one.
+func (f *clientFlags) explain(err error) error {
+	if errors.Is(err, client.ErrNoSession) {
+		return fmt.Errorf("%w: set $AOC_SESSION or write the session cookie to %s", err, *f.sessionFile)
+	}
+	return err
+}
+
+// fetchCommand downloads the puzzle input for a day into the day's directory. It never replaces an input that is
+// already there unless --force is given, as the answers recorded for it would no longer match.
+func fetchCommand(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	clientOptions := addClientFlags(flags)
+	yearNumber := addYearFlag(flags)
+	output := flags.String("output", "", "file to write the input to, or - for standard output (default day-NN/input.txt)")
+	force := flags.Bool("force", false, "overwrite the file if it already exists")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc fetch <day> [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	number, err := strconv.Atoi(positional[0])
+	if err != nil || number < 1 || number > 25 {
+		return fmt.Errorf("invalid day %q", positional[0])
+	}
+
+	fileName := *output
+	if fileName == "" {
+		fileName = filepath.Join(day{Year: *yearNumber, Number: number}.dir(), "input.txt")
+	}
+	if fileName != "-" && !*force {
+		if _, err := os.Stat(fileName); err == nil {
+			return fmt.Errorf("%s already exists (rerun with --force to overwrite it)", fileName)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	c, err := clientOptions.client()
+	if err != nil {
+		return err
+	}
+	cached := c.Cached(*yearNumber, number)
+	input, err := c.Input(context.Background(), *yearNumber, number)
+	if err != nil {
+		return clientOptions.explain(err)
+	}
+
+	if fileName == "-" {
+		_, err := os.Stdout.Write(input)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+		return err
+	}
+	if err := writeInput(fileName, input, *force); err != nil {
+		return err
+	}
+
+	source := "downloaded"
+	if cached {
+		source = "copied from cache"
+	}
+	fmt.Fprintf(os.Stderr, "day %d input %s to %s\n", number, source, fileName)
+	return nil
+}
+
+// writeInput writes a puzzle input to a file. Unless overwrite is set, it fails if the file already exists, even if
+// it was created since fetchCommand checked.
+func writeInput(fileName string, input []byte, overwrite bool) error {
+	mode := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		mode |= os.O_EXCL
+	}
+	file, err := os.OpenFile(fileName, mode, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(input); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFetchCommand(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2024/day/2/input", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "7 6 4 2 1\n")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Setenv("AOC_SESSION", "secret")
+	fileName := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(fileName, []byte("solved input\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"2", "--output", fileName, "--url", server.URL, "--cache", t.TempDir(), "--interval", "0s",
+		"--year", "2024"}
+	if err := fetchCommand(args); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("got error %v, want the existing input to be kept", err)
+	}
+	if data, err := os.ReadFile(fileName); err != nil || string(data) != "solved input\n" {
+		t.Errorf("got input %q, %v, want the existing input", data, err)
+	}
+
+	if err := fetchCommand(append(args, "--force")); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(fileName); err != nil || string(data) != "7 6 4 2 1\n" {
+		t.Errorf("got input %q, %v, want the downloaded input", data, err)
+	}
+}
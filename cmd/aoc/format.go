@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// formats are the output formats supported by writeResults.
+var formats = []string{"text", "json", "csv"}
+
+// record is the machine-readable form of a result, shared by the JSON and CSV formats.
+type record struct {
+	Day        int    `json:"day"`
+	Part       int    `json:"part"`
+	Answer     string `json:"answer"`
+	AnswerType string `json:"answerType"`
+	ElapsedNs  int64  `json:"elapsedNs"`
+	Input      string `json:"input,omitempty"`
+	InputHash  string `json:"inputHash"`
+	Cached     bool   `json:"cached,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// newRecord converts a result to a record.
+func newRecord(r result) record {
+	rec := record{
+		Day:        r.Day,
+		Part:       r.Part,
+		Answer:     r.Answer.String(),
+		AnswerType: r.Answer.Kind().String(),
+		ElapsedNs:  r.Elapsed.Nanoseconds(),
+		Input:      r.Input,
+		InputHash:  r.InputHash,
+		Cached:     r.Cached,
+	}
+	if r.Err != nil {
+		rec.Error = r.Err.Error()
+	}
+	return rec
+}
+
+// writeResults writes the results in the named format. The text format shows a single day as a list of parts and
+// several days as a table.
+func writeResults(w io.Writer, results []result, format string, table bool) error {
+	switch format {
+	case "json":
+		return writeJSON(w, results)
+	case "csv":
+		return writeCSV(w, results)
+	case "text":
+		if table {
+			return writeTable(w, results)
+		}
+		return writeParts(w, results)
+	}
+	return fmt.Errorf("invalid format %q", format)
+}
+
+// writeParts writes the answer to each part on its own line.
+func writeParts(w io.Writer, results []result) error {
+	for _, result := range results {
+		var err error
+		if result.Err != nil {
+			_, err = fmt.Fprintf(w, "part %d: error: %v\n", result.Part, result.Err)
+		} else {
+			_, err = fmt.Fprintf(w, "part %d: %s%s\n", result.Part, formatAnswer(result.Answer), cachedNote(result))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cachedNote returns a note marking a result whose answer came from the result cache.
+func cachedNote(r result) string {
+	if r.Cached {
+		return " (cached)"
+	}
+	return ""
+}
+
+// writeTable writes the results as a table with a total time.
+func writeTable(w io.Writer, results []result) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "DAY\tPART\tANSWER\tTIME")
+	var total time.Duration
+	for _, result := range results {
+		answer := formatAnswer(result.Answer)
+		if result.Err != nil {
+			answer = "error: " + result.Err.Error()
+		}
+		total += result.Elapsed
+		elapsed := formatDuration(result.Elapsed)
+		if result.Cached {
+			elapsed = "cached"
+		}
+		fmt.Fprintf(writer, "%d\t%d\t%s\t%s\n", result.Day, result.Part, answer, elapsed)
+	}
+	fmt.Fprintf(writer, "\t\ttotal\t%s\n", formatDuration(total))
+	return writer.Flush()
+}
+
+// writeInputTable writes the results for a batch of inputs to one day as a table with a total time.
+func writeInputTable(w io.Writer, results []result) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "INPUT\tPART\tANSWER\tTIME")
+	var total time.Duration
+	for _, result := range results {
+		answer := formatAnswer(result.Answer)
+		if result.Err != nil {
+			answer = "error: " + result.Err.Error()
+		}
+		total += result.Elapsed
+		elapsed := formatDuration(result.Elapsed)
+		if result.Cached {
+			elapsed = "cached"
+		}
+		fmt.Fprintf(writer, "%s\t%d\t%s\t%s\n", result.Input, result.Part, answer, elapsed)
+	}
+	fmt.Fprintf(writer, "\t\ttotal\t%s\n", formatDuration(total))
+	return writer.Flush()
+}
+
+// writeJSON writes the results as a JSON array of records.
+func writeJSON(w io.Writer, results []result) error {
+	records := []record{}
+	for _, result := range results {
+		records = append(records, newRecord(result))
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(records)
+}
+
+// writeCSV writes the results as CSV with a header row, using the same field names as the JSON format.
+func writeCSV(w io.Writer, results []result) error {
+	writer := csv.NewWriter(w)
+	header := []string{"day", "part", "answer", "answerType", "elapsedNs", "input", "inputHash", "cached", "error"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, result := range results {
+		rec := newRecord(result)
+		row := []string{strconv.Itoa(rec.Day), strconv.Itoa(rec.Part), rec.Answer, rec.AnswerType,
+			strconv.FormatInt(rec.ElapsedNs, 10), rec.Input, rec.InputHash, strconv.FormatBool(rec.Cached), rec.Error}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
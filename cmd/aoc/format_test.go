@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+var formatResults = []result{
+	{Day: 17, Part: 1, Input: "input.txt", Answer: solver.StringAnswer("4,6,3"), Elapsed: 1500 * time.Nanosecond,
+		InputHash: "abc"},
+	{Day: 17, Part: 2, Input: "input.txt", InputHash: "abc", Err: errors.New("no answer")},
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := writeResults(&buffer, formatResults, "json", false); err != nil {
+		t.Fatal(err)
+	}
+	records := []map[string]any{}
+	if err := json.Unmarshal(buffer.Bytes(), &records); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	want := map[string]any{"day": 17.0, "part": 1.0, "answer": "4,6,3", "answerType": "string", "elapsedNs": 1500.0,
+		"input": "input.txt", "inputHash": "abc"}
+	for key, value := range want {
+		if records[0][key] != value {
+			t.Errorf("%s: got %v, want %v", key, records[0][key], value)
+		}
+	}
+	if _, ok := records[0]["error"]; ok {
+		t.Error("successful result has an error field")
+	}
+	if records[1]["error"] != "no answer" || records[1]["answerType"] != "none" {
+		t.Errorf("failed result recorded as %v", records[1])
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := writeResults(&buffer, formatResults, "csv", true); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || rows[0][2] != "answer" || rows[1][2] != "4,6,3" || rows[1][5] != "input.txt" ||
+		rows[1][7] != "false" || rows[2][8] != "no answer" {
+		t.Errorf("got rows %q", rows)
+	}
+}
+
+func TestWriteInputTable(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := writeInputTable(&buffer, formatResults); err != nil {
+		t.Fatal(err)
+	}
+	want := `INPUT      PART  ANSWER            TIME
+input.txt  1     4,6,3             1.5µs
+input.txt  2     error: no answer  0s
+                 total             1.5µs
+`
+	if got := buffer.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// genCommand writes a random puzzle input for a day.
+func genCommand(args []string) error {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	seed := flags.Uint64("seed", 0, "seed for the random input, or 0 to pick one and report it")
+	output := flags.String("output", "-", "file to write the input to, or - for standard output")
+	params := map[string]int{}
+	flags.Func("param", "set a generator parameter as `name=value` (repeatable)", func(value string) error {
+		override, err := parseParamOverride(value)
+		if err != nil {
+			return err
+		}
+		if override.Day != 0 {
+			return fmt.Errorf("generator parameter %q cannot name a day", value)
+		}
+		params[override.Name] = override.Value
+		return nil
+	})
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc gen <day> [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	number, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid day %q", positional[0])
+	}
+	d, err := findDay(*yearNumber, number)
+	if err != nil {
+		return err
+	}
+
+	if d.NewGenerator == nil {
+		return fmt.Errorf("day %d of %d has no generator", d.Number, d.Year)
+	}
+	g := d.NewGenerator()
+	for name := range params {
+		if err := checkParamOf(fmt.Sprintf("the generator for day %d", d.Number), g, name); err != nil {
+			return err
+		}
+	}
+	if err := solver.SetParams(g, params); err != nil {
+		return err
+	}
+	if *seed == 0 {
+		*seed = rand.Uint64()
+		fmt.Fprintf(os.Stderr, "day %d: seed %d\n", d.Number, *seed)
+	}
+
+	var buffer bytes.Buffer
+	if err := g.Generate(&buffer, rand.New(rand.NewPCG(*seed, *seed))); err != nil {
+		return fmt.Errorf("day %d: %w", d.Number, err)
+	}
+	if *output == "-" {
+		_, err := os.Stdout.Write(buffer.Bytes())
+		return err
+	}
+	return os.WriteFile(*output, buffer.Bytes(), 0o644)
+}
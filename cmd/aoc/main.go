@@ -0,0 +1,108 @@
+// Command aoc runs the Advent of Code solutions.
+//
+// Usage:
+//
+//	aoc run <day|all> [--part 1|2] [--input path|-|dir] [--format text|json|csv] [--timeout d] [--workers n]
+//	    [--visualize] [--export file.png|file.gif [--scale n] [--every n]] [--fps n] [--config file]
+//	    [--bigint] [--cache dir] [--no-cache] [--cpuprofile file] [--memprofile file] [--trace file]
+//	    [--trace-events json|text] [--param [day.]name=value]
+//	aoc bench [day|all] [--part 1|2] [--sort day|time|allocs|bytes] [--save file] [--baseline file]
+//	aoc fetch <day> [--output path] [--url url] [--session-file path] [--cache dir]
+//	aoc submit <day> <part> [--input path] [--bigint] [--no-cache] [--ledger file] [--url url]
+//	    [--session-file path] [--cache dir]
+//	aoc params [day|all] [--config file] [--param [day.]name=value]
+//	aoc gen <day> [--seed n] [--param name=value] [--output path]
+//	aoc certify <day> [--part 1|2] [--input path] [--config file] [--param [day.]name=value]
+//	aoc verify [day|all] [--part 1|2] [--input path] [--snapshot file] [--update] [--timeout d]
+//	    [--workers n] [--config file] [--param [day.]name=value]
+//	aoc profile <day> [--part 1|2] [--input path] [--top n] [--timeout d] [--bigint] [--config file]
+//	    [--param [day.]name=value]
+//	aoc cache prune [--cache dir] [--all]
+//	aoc new <year> <day>
+//	aoc serve [--addr host:port] [--max-input n] [--timeout d] [--workers n] [--config file]
+//	    [--param [day.]name=value]
+//
+// The commands that work on days, other than new, also take --year n to choose the year of the puzzles, which
+// defaults to the latest.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+// command is a subcommand of aoc.
+type command struct {
+	Name    string
+	Summary string
+	Run     func(args []string) error
+}
+
+var commands = []command{
+	{Name: "run", Summary: "run the solution for a day, or for all days", Run: runCommand},
+	{Name: "bench", Summary: "benchmark the solutions and compare them against a baseline", Run: benchCommand},
+	{Name: "fetch", Summary: "download the puzzle input for a day", Run: fetchCommand},
+	{Name: "submit", Summary: "submit the answer to one part of a day", Run: submitCommand},
+	{Name: "params", Summary: "list the parameters of each day and the values they will take", Run: paramsCommand},
+	{Name: "gen", Summary: "generate a random puzzle input for a day", Run: genCommand},
+	{Name: "certify", Summary: "solve a day with evidence for each answer and check the evidence", Run: certifyCommand},
+	{Name: "verify", Summary: "check the answers against the recorded snapshot, or update it", Run: verifyCommand},
+	{Name: "profile", Summary: "profile the parts of a day and list the hottest functions", Run: profileCommand},
+	{Name: "cache", Summary: "remove cached answers that can no longer be used", Run: cacheCommand},
+	{Name: "new", Summary: "create the directory for a new day from the template", Run: newCommand},
+	{Name: "serve", Summary: "serve an HTTP API that solves puzzle inputs", Run: serveCommand},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("aoc: ")
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.Name == os.Args[1] {
+			err := cmd.Run(os.Args[2:])
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(2)
+			} else if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+// usage prints the list of available commands.
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [arguments]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.Name, cmd.Summary)
+	}
+}
+
+// parseArgs parses flags that may appear before or after positional arguments and returns the positional arguments.
+func parseArgs(flags *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+// firstYear is the first year of Advent of Code.
+const firstYear = 2015
+
+// newCommand creates the directory for a new day, with a stub solver, tests and example input in the style of the
+// other days, and registers the day with the runner.
+func newCommand(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc new <year> <day>")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	yearNumber, err := strconv.Atoi(positional[0])
+	if err != nil || yearNumber < firstYear {
+		return fmt.Errorf("invalid year %q", positional[0])
+	}
+	number, err := strconv.Atoi(positional[1])
+	if err != nil || number < 1 || number > 25 {
+		return fmt.Errorf("invalid day %q", positional[1])
+	}
+
+	modulePath, err := readModulePath("go.mod")
+	if err != nil {
+		return fmt.Errorf("%w (run aoc new from the root of the repository)", err)
+	}
+	d := day{Year: yearNumber, Number: number}
+	if err := scaffoldDay(".", modulePath, d); err != nil {
+		return err
+	}
+	registry, err := writeRegistry(".", modulePath, yearNumber)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created %s and registered it in %s\n", d.dir(), registry)
+	return nil
+}
+
+// readModulePath returns the module path declared in a go.mod file.
+func readModulePath(fileName string) (string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(path), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s has no module directive", fileName)
+}
+
+// dayTemplate is the data for the templates of a new day.
+type dayTemplate struct {
+	Module  string
+	Year    int
+	Day     int
+	Package string
+	// Final is set for the last day of the year, which only has part one.
+	Final bool
+}
+
+// scaffoldDay creates the directory for a new day under root from the templates. It fails if the directory already
+// exists, so that it never overwrites a solution.
+func scaffoldDay(root, modulePath string, d day) error {
+	if y, err := findYear(d.Year); err == nil && y.Dir != strconv.Itoa(d.Year) {
+		return fmt.Errorf("the days of %d are registered by hand in cmd/aoc/days.go", d.Year)
+	}
+	dir := filepath.Join(root, d.dir())
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	data := dayTemplate{
+		Module:  modulePath,
+		Year:    d.Year,
+		Day:     d.Number,
+		Package: fmt.Sprintf("day%02d", d.Number),
+		Final:   d.Number == 25,
+	}
+	files := []struct {
+		name string
+		tmpl *template.Template
+	}{
+		{data.Package + ".go", solverFile},
+		{data.Package + "_test.go", testFile},
+		{solvertest.ManifestFile, expectedFile},
+	}
+	for _, file := range files {
+		if err := writeTemplate(filepath.Join(dir, file.name), file.tmpl, data); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(dir, "test_data.txt"), nil, 0o644)
+}
+
+// registryTemplate is the data for the template of a year's registry.
+type registryTemplate struct {
+	Module string
+	Year   int
+	Days   []dayTemplate
+}
+
+// dayDirPattern matches the name of a day's directory.
+var dayDirPattern = regexp.MustCompile(`^day-(\d\d)$`)
+
+// writeRegistry generates the file that registers every day of a year under root with the runner, and returns its
+// name.
+func writeRegistry(root, modulePath string, yearNumber int) (string, error) {
+	entries, err := os.ReadDir(filepath.Join(root, strconv.Itoa(yearNumber)))
+	if err != nil {
+		return "", err
+	}
+	data := registryTemplate{Module: modulePath, Year: yearNumber}
+	for _, entry := range entries {
+		match := dayDirPattern.FindStringSubmatch(entry.Name())
+		if !entry.IsDir() || match == nil {
+			continue
+		}
+		number, _ := strconv.Atoi(match[1])
+		data.Days = append(data.Days, dayTemplate{
+			Year:    yearNumber,
+			Day:     number,
+			Package: fmt.Sprintf("day%02d", number),
+			Final:   number == 25,
+		})
+	}
+
+	fileName := filepath.Join(root, "cmd", "aoc", fmt.Sprintf("year%d.go", yearNumber))
+	return fileName, writeTemplate(fileName, registryFile, data)
+}
+
+// writeTemplate executes a template into a file, formatting it first if it is Go source.
+func writeTemplate(fileName string, tmpl *template.Template, data any) error {
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return err
+	}
+	contents := buffer.Bytes()
+	if filepath.Ext(fileName) == ".go" {
+		formatted, err := format.Source(contents)
+		if err != nil {
+			return fmt.Errorf("formatting %s: %w", fileName, err)
+		}
+		contents = formatted
+	}
+	return os.WriteFile(fileName, contents, 0o644)
+}
+
+// registryFile is the template for the file that registers the days of a year.
+var registryFile = template.Must(template.New("registry").Parse(`// Code generated by "aoc new"; DO NOT EDIT.
+
+package main
+
+import (
+{{- range .Days}}
+	"{{$.Module}}/{{$.Year}}/day-{{printf "%02d" .Day}}"
+{{- end}}
+	"{{.Module}}/solver"
+)
+
+func init() {
+	registerYear({{.Year}}, "{{.Year}}", []day{
+{{- range .Days}}
+		{Number: {{.Day}}, Input: "input.txt", Parts: {{if .Final}}1{{else}}2{{end}},
+			New: func() solver.Solver { return {{.Package}}.New() }},
+{{- end}}
+	})
+}
+`))
+
+// solverFile is the template for the solver of a new day.
+var solverFile = template.Must(template.New("solver").Parse(`package {{.Package}}
+
+import (
+	"context"
+	"io"
+
+	"{{.Module}}/parse"
+	"{{.Module}}/solver"
+)
+
+// Solver solves the puzzle for day {{.Day}} of {{.Year}}.
+type Solver struct {
+	lines []string
+}
+
+// New creates a solver for the puzzle for day {{.Day}} of {{.Year}}.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.lines = lines
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.lines)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+{{if .Final}}
+// PartTwo reports that there is no part two, as the final day only has one part.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	return solver.Answer{}, solver.ErrNoPart
+}
+{{else}}
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.lines)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+{{end}}
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, lines []string) (int, error) {
+	return 0, solver.ErrUnsolved
+}
+{{if not .Final}}
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, lines []string) (int, error) {
+	return 0, solver.ErrUnsolved
+}
+{{end}}
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]string, error) {
+	scanner := parse.NewScanner(r)
+
+	lines := []string{}
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+`))
+
+// testFile is the template for the tests of a new day.
+var testFile = template.Must(template.New("test").Parse(`package {{.Package}}
+
+import (
+	"testing"
+
+	"{{.Module}}/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+{{if not .Final}}
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
+{{end}}`))
+
+// expectedFile is the template for the expected answers of a new day, which start out unknown.
+var expectedFile = template.Must(template.New("expected").Parse(`[
+	{
+		"input": "test_data.txt"
+	},
+	{
+		"input": "input.txt"
+	}
+]
+`))
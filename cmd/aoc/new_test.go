@@ -0,0 +1,83 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDayDir(t *testing.T) {
+	tests := []struct {
+		d    day
+		want string
+	}{
+		{day{Year: 2024, Number: 5}, "day-05"},
+		{day{Year: 2031, Number: 12}, filepath.Join("2031", "day-12")},
+	}
+	for _, test := range tests {
+		if got := test.d.dir(); got != test.want {
+			t.Errorf("day %d of %d: got directory %q, want %q", test.d.Number, test.d.Year, got, test.want)
+		}
+	}
+}
+
+func TestScaffoldDay(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "cmd", "aoc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	const module = "example.com/aoc"
+	for _, number := range []int{3, 25} {
+		if err := scaffoldDay(root, module, day{Year: 2031, Number: number}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fset := token.NewFileSet()
+	for _, fileName := range []string{"day03.go", "day03_test.go", "expected.json", "test_data.txt"} {
+		if _, err := os.Stat(filepath.Join(root, "2031", "day-03", fileName)); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, fileName := range []string{"day03.go", "day03_test.go", "day25.go", "day25_test.go"} {
+		dir := "day-" + fileName[3:5]
+		if _, err := parser.ParseFile(fset, filepath.Join(root, "2031", dir, fileName), nil, 0); err != nil {
+			t.Error(err)
+		}
+	}
+	solution, err := os.ReadFile(filepath.Join(root, "2031", "day-25", "day25.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(solution), "solver.ErrNoPart") || strings.Contains(string(solution), "func partTwo") {
+		t.Error("day 25 has a part two")
+	}
+
+	registry, err := writeRegistry(root, module, 2031)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(fset, registry, nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"example.com/aoc/2031/day-03"`, `"example.com/aoc/2031/day-25"`,
+		"return day03.New()", "Number: 25, Input: \"input.txt\", Parts: 1"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("registry does not contain %s:\n%s", want, data)
+		}
+	}
+
+	if err := scaffoldDay(root, module, day{Year: 2031, Number: 3}); err == nil {
+		t.Error("scaffolded a day that already exists")
+	}
+	if err := scaffoldDay(root, module, day{Year: 2024, Number: 26}); err == nil {
+		t.Error("scaffolded a day of 2024, which is registered by hand")
+	}
+}
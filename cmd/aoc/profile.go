@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+	"time"
+)
+
+// profileFiles names the files that the profiles of a part are written to. An empty name skips that profile.
+type profileFiles struct {
+	cpu   string
+	mem   string
+	trace string
+}
+
+// enabled reports whether any profile is requested.
+func (f profileFiles) enabled() bool {
+	return f.cpu != "" || f.mem != "" || f.trace != ""
+}
+
+// memBase returns the name of the memory profile taken just before the part, which sits beside the memory profile, so
+// that the base of mem.pprof is mem.base.pprof.
+func (f profileFiles) memBase() string {
+	ext := filepath.Ext(f.mem)
+	return strings.TrimSuffix(f.mem, ext) + ".base" + ext
+}
+
+// memCommand returns the pprof command that shows the memory allocated by the part alone.
+func (f profileFiles) memCommand() string {
+	return fmt.Sprintf("go tool pprof -sample_index=alloc_space -base %s %s", f.memBase(), f.mem)
+}
+
+// profileCommand profiles the parts of a day, writing the profiles next to the input and printing the functions that
+// used the most CPU time, as listed by go tool pprof.
+func profileCommand(args []string) error {
+	flags := flag.NewFlagSet("profile", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	part := flags.Int("part", 0, "profile only the given part (1 or 2)")
+	input := flags.String("input", "", "path to the puzzle input (defaults to the day's input file)")
+	top := flags.Int("top", 10, "number of functions to list for each part")
+	timeout := flags.Duration("timeout", 0, "time allowed for each part (defaults to the day's own budget)")
+	bigInt := flags.Bool("bigint", false, "use big integers if the day's answers can outgrow an int")
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc profile <day> [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 || positional[0] == "all" {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+	if *top < 1 {
+		return fmt.Errorf("invalid number of functions %d", *top)
+	}
+	if *timeout < 0 {
+		return fmt.Errorf("invalid timeout %s", *timeout)
+	}
+	selected, err := selectDays(*yearNumber, positional)
+	if err != nil {
+		return err
+	}
+	params, err := paramOptions.load(*yearNumber, selected)
+	if err != nil {
+		return err
+	}
+
+	d := selected[0]
+	if *part > d.Parts {
+		return fmt.Errorf("day %d has no part %d", d.Number, *part)
+	}
+	fileName := inputPaths(*input)(d)
+	if fileName == stdinName {
+		return errors.New("aoc profile writes the profiles next to the input, so it cannot read standard input")
+	}
+	selectedParts := parts(d, *part)
+	failures := 0
+	for _, p := range selectedParts {
+		files := profilesBeside(fileName, p)
+		r, err := profileDay(d, p, fileName, params(d), *bigInt, *timeout, files)
+		if err != nil {
+			return err
+		}
+		if r.Err != nil {
+			failures++
+			fmt.Printf("day %d part %d: error: %v\n", r.Day, r.Part, r.Err)
+		} else {
+			fmt.Printf("day %d part %d: %s in %s\n", r.Day, r.Part, formatAnswer(r.Answer), formatDuration(r.Elapsed))
+		}
+		fmt.Printf("cpu profile:    %s\nmemory profile: %s\ntrace:          %s\n", files.cpu, files.mem, files.trace)
+		fmt.Printf("memory of the part alone: %s\n", files.memCommand())
+
+		if err := writeTop(os.Stdout, files.cpu, *top); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d parts failed", failures, len(selectedParts))
+	}
+	return nil
+}
+
+// profilesBeside returns the names of the profiles of a part that sit next to its input file, so that the profiles of
+// day-06/input.txt part 2 are day-06/input.part2.cpu.pprof, and so on.
+func profilesBeside(inputFile string, part int) profileFiles {
+	base := fmt.Sprintf("%s.part%d", strings.TrimSuffix(inputFile, filepath.Ext(inputFile)), part)
+	return profileFiles{cpu: base + ".cpu.pprof", mem: base + ".mem.pprof", trace: base + ".trace.out"}
+}
+
+// profileDay parses the input for a day and then solves one part while capturing the requested profiles, so that the
+// profiles cover the part alone. The result records any error from parsing or solving; the returned error is for
+// profiles that could not be written.
+func profileDay(d day, part int, fileName string, params map[string]int, bigInt bool, timeout time.Duration,
+	files profileFiles) (result, error) {
+	s, results := prepareDay(d, part, fileName, params, bigInt)
+	r := results[0]
+	if r.Err != nil {
+		return r, nil
+	}
+	if timeout == 0 {
+		timeout = d.budget()
+	}
+	err := withProfiles(files, func() {
+		start := time.Now()
+		r.Answer, r.Err = solvePart(context.Background(), s, part, timeout)
+		r.Elapsed = time.Since(start)
+	})
+	r.Err = overflowHint(s, bigInt, r.Err)
+	return r, err
+}
+
+// withProfiles calls f while recording a CPU profile and an execution trace, and then writes a profile of the memory
+// allocated so far, as requested by files. The allocations in the memory profile build up over the whole run, so a
+// second memory profile is written just before f is called, to be subtracted with the -base flag of pprof.
+func withProfiles(files profileFiles, f func()) (err error) {
+	create := func(fileName string) (*os.File, error) {
+		if dir := filepath.Dir(fileName); dir != "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, err
+			}
+		}
+		return os.Create(fileName)
+	}
+	closeFile := func(file *os.File) {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	writeMem := func(fileName string) error {
+		file, err := create(fileName)
+		if err != nil {
+			return err
+		}
+		runtime.GC() // bring the profile up to date with the allocations so far
+		if err := pprof.Lookup("allocs").WriteTo(file, 0); err != nil {
+			file.Close()
+			return fmt.Errorf("writing the memory profile: %w", err)
+		}
+		return file.Close()
+	}
+
+	if files.mem != "" {
+		if err := writeMem(files.memBase()); err != nil {
+			return err
+		}
+	}
+	if files.cpu != "" {
+		file, err := create(files.cpu)
+		if err != nil {
+			return err
+		}
+		defer closeFile(file)
+		if err := pprof.StartCPUProfile(file); err != nil {
+			return fmt.Errorf("starting the CPU profile: %w", err)
+		}
+		defer pprof.StopCPUProfile()
+	}
+	if files.trace != "" {
+		file, err := create(files.trace)
+		if err != nil {
+			return err
+		}
+		defer closeFile(file)
+		if err := trace.Start(file); err != nil {
+			return fmt.Errorf("starting the trace: %w", err)
+		}
+		defer trace.Stop()
+	}
+
+	f()
+
+	if files.mem != "" {
+		return writeMem(files.mem)
+	}
+	return nil
+}
+
+// writeTop writes the n functions that used the most CPU time in the named CPU profile, using go tool pprof -top.
+func writeTop(w io.Writer, fileName string, n int) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", "tool", "pprof", "-top", fmt.Sprintf("-nodecount=%d", n), fileName)
+	cmd.Stdout, cmd.Stderr = w, &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("listing the functions in %s with go tool pprof: %w: %s", fileName, err,
+			strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProfilesBeside(t *testing.T) {
+	got := profilesBeside(filepath.Join("day-06", "input.txt"), 2)
+	want := profileFiles{
+		cpu:   filepath.Join("day-06", "input.part2.cpu.pprof"),
+		mem:   filepath.Join("day-06", "input.part2.mem.pprof"),
+		trace: filepath.Join("day-06", "input.part2.trace.out"),
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestProfileCommand(t *testing.T) {
+	input, err := os.ReadFile("../../day-02/test_data.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "test_data.txt")
+	if err := os.WriteFile(fileName, input, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := profileCommand([]string{"2", "--input", fileName, "--year", "2024", "--top", "3"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, part := range []int{1, 2} {
+		files := profilesBeside(fileName, part)
+		for _, name := range []string{files.cpu, files.mem, files.memBase(), files.trace} {
+			info, err := os.Stat(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Size() == 0 {
+				t.Errorf("%s is empty", name)
+			}
+		}
+	}
+}
+
+func TestRunProfileFlags(t *testing.T) {
+	cpu := filepath.Join(t.TempDir(), "cpu.pprof")
+	args := []string{"2", "--input", "../../day-02/test_data.txt", "--year", "2024", "--cpuprofile", cpu}
+	if err := runCommand(args); err == nil || !strings.Contains(err.Error(), "need --part") {
+		t.Errorf("no part: got error %v, want a request for --part", err)
+	}
+	if err := runCommand([]string{"all", "--year", "2024", "--part", "1", "--cpuprofile", cpu}); err == nil {
+		t.Error("all days: got no error")
+	}
+	if err := runCommand(append(args, "--part", "2")); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(cpu); err != nil || info.Size() == 0 {
+		t.Errorf("got CPU profile %v, %v, want a profile", info, err)
+	}
+}
+
+func TestWriteTop(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the go command is not available")
+	}
+	fileName := filepath.Join(t.TempDir(), "cpu.pprof")
+	err := withProfiles(profileFiles{cpu: fileName}, func() {
+		for start := time.Now(); time.Since(start) < 50*time.Millisecond; {
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := writeTop(&buffer, fileName, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buffer.String(), "flat%") {
+		t.Errorf("got %q, want a table of functions", buffer.String())
+	}
+
+	if err := writeTop(&buffer, filepath.Join(t.TempDir(), "missing.pprof"), 1); err == nil {
+		t.Error("listed the functions of a missing profile")
+	}
+}
+
+// allocationSink keeps allocations alive, so that they cannot be optimized away.
+var allocationSink [][]byte
+
+//go:noinline
+func allocateBeforePart() {
+	allocationSink = append(allocationSink, make([]byte, 32<<20))
+}
+
+//go:noinline
+func allocateInPart() {
+	allocationSink = append(allocationSink, make([]byte, 8<<20))
+}
+
+func TestMemoryProfileCoversThePart(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the go command is not available")
+	}
+	defer func() { allocationSink = nil }()
+	allocateBeforePart()
+	files := profileFiles{mem: filepath.Join(t.TempDir(), "mem.pprof")}
+	if err := withProfiles(files, allocateInPart); err != nil {
+		t.Fatal(err)
+	}
+
+	// Run the suggested command with -top added after go tool pprof.
+	args := append([]string{"tool", "pprof", "-top"}, strings.Fields(files.memCommand())[3:]...)
+	output, err := exec.Command("go", args...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v: %s", err, output)
+	}
+	if !strings.Contains(string(output), "allocateInPart") || strings.Contains(string(output), "allocateBeforePart") {
+		t.Errorf("got allocations %s, want those of the part alone", output)
+	}
+}
@@ -0,0 +1,560 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/internal/cache"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// result is the outcome of running one part of a day.
+type result struct {
+	Day  int
+	Part int
+	// Input is the name of the input file, or - for standard input.
+	Input   string
+	Answer  solver.Answer
+	Elapsed time.Duration
+	// InputHash is the hex-encoded SHA-256 hash of the input, or empty if it could not be read.
+	InputHash string
+	// Cached is set if the answer came from the result cache rather than from solving the part.
+	Cached bool
+	Err    error
+}
+
+// runCommand runs the solution for a single day, or for all days.
+func runCommand(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	part := flags.Int("part", 0, "run only the given part (1 or 2)")
+	input := flags.String("input", "", "path to the puzzle input, - for standard input, or a directory of inputs to "+
+		"solve in turn (defaults to the day's input file)")
+	format := flags.String("format", "text", "output format: text, json or csv")
+	timeout := flags.Duration("timeout", 0, "time allowed for each part (defaults to each day's own budget)")
+	workers := flags.Int("workers", runtime.GOMAXPROCS(0), "number of parts to run at the same time")
+	visualize := flags.Bool("visualize", false, "animate a part of the day in the terminal instead of printing the answers")
+	exportFile := flags.String("export", "", "write the animation of a part to a .png (last frame) or .gif file")
+	fps := flags.Float64("fps", 30, "frames per second of the animation")
+	scale := flags.Int("scale", 4, "width in pixels of each cell of an exported image")
+	every := flags.Int("every", 1, "export only every nth frame of an animated GIF")
+	bigInt := flags.Bool("bigint", false, "use big integers in the days whose answers can outgrow an int")
+	cacheDir := flags.String("cache", defaultCacheDir(), "directory to cache answers in")
+	noCache := flags.Bool("no-cache", false, "solve every part, without reading or writing cached answers")
+	traceEvents := flags.String("trace-events", "", "write the steps of the solvers to standard error as json (one "+
+		"object per line) or text, without using cached answers")
+	var files profileFiles
+	flags.StringVar(&files.cpu, "cpuprofile", "", "write a CPU profile of a single part to the given file")
+	flags.StringVar(&files.mem, "memprofile", "", "write a memory profile of a single part to the given file")
+	flags.StringVar(&files.trace, "trace", "", "write an execution trace of a single part to the given file")
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc run <day|all> [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+	if *workers < 1 {
+		return fmt.Errorf("invalid number of workers %d", *workers)
+	}
+	if *timeout < 0 {
+		return fmt.Errorf("invalid timeout %s", *timeout)
+	}
+	if !slices.Contains(formats, *format) {
+		return fmt.Errorf("invalid format %q", *format)
+	}
+	if *fps <= 0 {
+		return fmt.Errorf("invalid frames per second %g", *fps)
+	}
+	if *scale < 1 {
+		return fmt.Errorf("invalid scale %d", *scale)
+	}
+	if *every < 1 {
+		return fmt.Errorf("invalid frame interval %d", *every)
+	}
+	if *exportFile != "" {
+		if err := checkExportFile(*exportFile); err != nil {
+			return err
+		}
+	}
+	tracer, err := newTracer(*traceEvents, os.Stderr)
+	if err != nil {
+		return err
+	}
+	answers := resultCache(*cacheDir, *noCache)
+	if tracer.Enabled() {
+		answers = nil // a cached answer would skip the steps that are being traced
+	}
+
+	all := positional[0] == "all"
+	if all && *input != "" {
+		return errors.New("--input cannot be used when running all days")
+	}
+	selected, err := selectDays(*yearNumber, positional)
+	if err != nil {
+		return err
+	}
+	params, err := paramOptions.load(*yearNumber, selected)
+	if err != nil {
+		return err
+	}
+
+	if isDir(*input) {
+		if *visualize || *exportFile != "" || files.enabled() {
+			return errors.New("a directory of inputs cannot be visualized or profiled")
+		}
+		fileNames, err := batchInputs(*input)
+		if err != nil {
+			return err
+		}
+		jobs := []job{}
+		for _, fileName := range fileNames {
+			jobs = append(jobs, job{day: selected[0], input: fileName})
+		}
+		results := solveJobs(jobs, *part, params, *timeout, *workers, *bigInt, answers, tracer)
+		if *format == "text" {
+			err = writeInputTable(os.Stdout, results)
+		} else {
+			err = writeResults(os.Stdout, results, *format, true)
+		}
+		if err != nil {
+			return err
+		}
+		return checkResults(results)
+	}
+	if *visualize || *exportFile != "" {
+		if all {
+			return errors.New("--visualize and --export can only be used with a single day")
+		}
+		d := selected[0]
+		frames, err := animation(d, max(*part, 1), inputPaths(*input)(d), params(d))
+		if err != nil {
+			return err
+		}
+		delay := time.Duration(float64(time.Second) / *fps)
+		if *exportFile != "" {
+			return export(frames, *exportFile, *scale, delay, *every)
+		}
+		return play(frames, delay)
+	}
+	if files.enabled() {
+		if all {
+			return errors.New("--cpuprofile, --memprofile and --trace can only be used with a single day")
+		}
+		d := selected[0]
+		if *part == 0 && d.Parts > 1 {
+			return errors.New("--cpuprofile, --memprofile and --trace need --part to choose the part to profile")
+		}
+		if *part > d.Parts {
+			return fmt.Errorf("day %d has no part %d", d.Number, *part)
+		}
+		r, err := profileDay(d, max(*part, 1), inputPaths(*input)(d), params(d), *bigInt, *timeout, files)
+		if err != nil {
+			return err
+		}
+		if err := writeResults(os.Stdout, []result{r}, *format, false); err != nil {
+			return err
+		}
+		if files.mem != "" {
+			fmt.Fprintf(os.Stderr, "memory of the part alone: %s\n", files.memCommand())
+		}
+		return checkResults([]result{r})
+	}
+	results := solveJobs(dayJobs(selected, inputPaths(*input)), *part, params, *timeout, *workers, *bigInt, answers,
+		tracer)
+	if err := writeResults(os.Stdout, results, *format, all); err != nil {
+		return err
+	}
+	return checkResults(results)
+}
+
+// checkResults returns an error reporting how many parts failed, if any did.
+func checkResults(results []result) error {
+	failures := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failures++
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d parts failed", failures, len(results))
+	}
+	return nil
+}
+
+// parts returns the parts of a day to run, where 0 means every part the day has.
+func parts(d day, part int) []int {
+	if part != 0 {
+		return []int{part}
+	}
+	all := []int{}
+	for p := 1; p <= d.Parts; p++ {
+		all = append(all, p)
+	}
+	return all
+}
+
+// inputPaths returns a function giving the input file for a day, which is fileName if it is set and otherwise the
+// day's default input file.
+func inputPaths(fileName string) func(day) string {
+	return func(d day) string {
+		if fileName != "" {
+			return fileName
+		}
+		return d.inputPath()
+	}
+}
+
+// isDir reports whether the named file is a directory.
+func isDir(fileName string) bool {
+	if fileName == "" || fileName == stdinName {
+		return false
+	}
+	info, err := os.Stat(fileName)
+	return err == nil && info.IsDir()
+}
+
+// batchInputs returns the input files in a directory: every .txt file that is not hidden, in order of name.
+func batchInputs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fileNames := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && filepath.Ext(name) == ".txt" && !strings.HasPrefix(name, ".") {
+			fileNames = append(fileNames, filepath.Join(dir, name))
+		}
+	}
+	if len(fileNames) == 0 {
+		return nil, fmt.Errorf("no .txt input files in %s", dir)
+	}
+	return fileNames, nil
+}
+
+// job is a day to solve for one input file.
+type job struct {
+	day   day
+	input string
+}
+
+// dayJobs returns a job for each of the selected days, for the input file given by inputPath.
+func dayJobs(selected []day, inputPath func(day) string) []job {
+	jobs := []job{}
+	for _, d := range selected {
+		jobs = append(jobs, job{day: d, input: inputPath(d)})
+	}
+	return jobs
+}
+
+// solveDays solves each of the selected days for the input file given by inputPath, as described for solveJobs,
+// without tracing.
+func solveDays(selected []day, part int, inputPath func(day) string, params func(day) map[string]int,
+	timeout time.Duration, workers int, bigInt bool, answers *cache.Cache) []result {
+	return solveJobs(dayJobs(selected, inputPath), part, params, timeout, workers, bigInt, answers, events.Discard)
+}
+
+// solveJobs sets the parameters of each job's solver and parses its input, and then runs the requested parts on a
+// pool of workers. The results are in the same order as the jobs and parts, however long each part takes. Each part
+// may take up to timeout, or its day's budget if timeout is zero. If bigInt is set, the days that can use big
+// integers do so. Parts whose answers are in the result cache are not solved again, and new answers are added to it;
+// a nil cache turns this off. The events of each part go to the tracer, labelled with the day and part, and with the
+// input if the day is solved for more than one.
+func solveJobs(jobs []job, part int, params func(day) map[string]int, timeout time.Duration, workers int,
+	bigInt bool, answers *cache.Cache, tracer events.Tracer) []result {
+	// task is a part waiting to be solved, identified by the index of its result.
+	type task struct {
+		index   int
+		solver  solver.Solver
+		timeout time.Duration
+		key     cache.Key
+		cache   bool // whether to store the answer under key
+		tracer  events.Tracer
+	}
+
+	inputs := map[int]int{} // the number of inputs for each day
+	for _, j := range jobs {
+		inputs[j.day.Number]++
+	}
+
+	results := []result{}
+	tasks := []task{}
+	for _, j := range jobs {
+		d := j.day
+		s, dayResults := prepareDay(d, part, j.input, params(d), bigInt)
+		for i := range dayResults {
+			if dayResults[i].Err != nil {
+				continue
+			}
+			attrs := []events.Attr{events.Int("day", d.Number), events.Int("part", dayResults[i].Part)}
+			if inputs[d.Number] > 1 {
+				attrs = append(attrs, events.String("input", j.input))
+			}
+			t := task{index: len(results) + i, solver: s, timeout: timeout, tracer: events.With(tracer, attrs...)}
+			if t.timeout == 0 {
+				t.timeout = d.budget()
+			}
+			if answers != nil {
+				t.key, t.cache = cacheKey(d, s, dayResults[i].Part, dayResults[i].InputHash, bigInt)
+			}
+			if t.cache {
+				if answer, ok := answers.Get(t.key); ok {
+					dayResults[i].Answer, dayResults[i].Cached = answer, true
+					continue
+				}
+			}
+			tasks = append(tasks, t)
+		}
+		results = append(results, dayResults...)
+	}
+
+	queue := make(chan task)
+	var wg sync.WaitGroup
+	for range min(workers, len(tasks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range queue {
+				start := time.Now()
+				ctx := context.Background()
+				if t.tracer.Enabled() {
+					ctx = events.NewContext(ctx, t.tracer)
+				}
+				results[t.index].Answer, results[t.index].Err = solvePart(ctx, t.solver, results[t.index].Part,
+					t.timeout)
+				results[t.index].Elapsed = time.Since(start)
+				results[t.index].Err = overflowHint(t.solver, bigInt, results[t.index].Err)
+				if t.cache && results[t.index].Err == nil {
+					if err := answers.Put(t.key, results[t.index].Answer); err != nil {
+						log.Printf("caching the answer to day %d part %d: %v", results[t.index].Day,
+							results[t.index].Part, err)
+					}
+				}
+			}
+		}()
+	}
+	for _, t := range tasks {
+		queue <- t
+	}
+	close(queue)
+	wg.Wait()
+	return results
+}
+
+// newTracer returns a tracer that writes the solvers' events to w in the named format, json or text, or
+// events.Discard if the format is empty.
+func newTracer(format string, w io.Writer) (events.Tracer, error) {
+	switch format {
+	case "":
+		return events.Discard, nil
+	case "json":
+		return events.NewJSONLines(w), nil
+	case "text":
+		return events.NewText(w), nil
+	}
+	return nil, fmt.Errorf("invalid trace event format %q", format)
+}
+
+// overflowHint adds a suggestion to rerun with --bigint to an overflow error from a solver that can use big integers
+// but was not asked to.
+func overflowHint(s solver.Solver, bigInt bool, err error) error {
+	if _, ok := s.(solver.BigSolver); ok && !bigInt && errors.Is(err, checked.ErrOverflow) {
+		return fmt.Errorf("%w (rerun with --bigint)", err)
+	}
+	return err
+}
+
+// cacheKey returns the key for the answer to a part of a day in the result cache, taking the parameters from the
+// solver after they have been set. It returns false if the solver has no version, as its answers cannot be cached.
+func cacheKey(d day, s solver.Solver, part int, inputHash string, bigInt bool) (cache.Key, bool) {
+	versioned, ok := s.(solver.Versioned)
+	if !ok {
+		return cache.Key{}, false
+	}
+	params := map[string]int{}
+	for _, param := range solver.Params(s) {
+		params[param.Name] = param.Value
+	}
+	_, canBigInt := s.(solver.BigSolver)
+	return cache.Key{Year: d.Year, Day: d.Number, Part: part, InputHash: inputHash, Params: params,
+		BigInt: bigInt && canBigInt, Version: versioned.Version()}, true
+}
+
+// defaultCacheDir returns the directory that aoc caches downloads and answers in, under the user's cache directory
+// ($XDG_CACHE_HOME or ~/.cache on Linux).
+func defaultCacheDir() string {
+	dir, _ := os.UserCacheDir()
+	return filepath.Join(dir, "aoc")
+}
+
+// resultCache returns the cache of answers within a cache directory, or nil if caching is turned off.
+func resultCache(cacheDir string, noCache bool) *cache.Cache {
+	if noCache {
+		return nil
+	}
+	return cache.New(filepath.Join(cacheDir, "results"))
+}
+
+// prepareDay creates a day's solver, sets its parameters, switches it to big integers if bigInt is set and it can use
+// them, and parses the named input file, returning the solver along with a result for each requested part. If
+// anything goes wrong, every result records the error.
+func prepareDay(d day, part int, fileName string, params map[string]int, bigInt bool) (solver.Solver, []result) {
+	results := []result{}
+	for _, p := range parts(d, part) {
+		results = append(results, result{Day: d.Number, Part: p, Input: fileName})
+	}
+
+	s := d.New()
+	if b, ok := s.(solver.BigSolver); ok {
+		b.SetBigInt(bigInt)
+	}
+	hash := ""
+	err := solver.SetParams(s, params)
+	if err == nil {
+		err = recoverPanic(func() (err error) {
+			hash, err = parseFile(s, fileName)
+			return err
+		})
+	}
+	for i := range results {
+		results[i].InputHash = hash
+		results[i].Err = err
+	}
+	return s, results
+}
+
+// errTimeout is the error for a part that ran for longer than it was allowed.
+var errTimeout = errors.New("timed out")
+
+// solvePart runs one part of a puzzle, cancelling it once the timeout has passed or the parent context is done. A
+// solver that does not stop when its context is cancelled is left running in the background. A panic in the solver
+// is returned as an error.
+func solvePart(parent context.Context, s solver.Solver, part int, timeout time.Duration) (solver.Answer, error) {
+	return solvePartThen(parent, s, part, timeout, func() {})
+}
+
+// solvePartThen is solvePart, calling stopped once the solver has returned. For a solver left running in the
+// background, that is after solvePartThen has returned, and may be never.
+func solvePartThen(parent context.Context, s solver.Solver, part int, timeout time.Duration,
+	stopped func()) (solver.Answer, error) {
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	type outcome struct {
+		answer solver.Answer
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer stopped()
+		var o outcome
+		o.err = recoverPanic(func() error {
+			var err error
+			o.answer, err = solver.Part(ctx, s, part)
+			return err
+		})
+		done <- o
+	}()
+
+	select {
+	case o := <-done:
+		if errors.Is(o.err, context.DeadlineExceeded) && parent.Err() == nil {
+			return solver.Answer{}, fmt.Errorf("%w after %s", errTimeout, timeout)
+		}
+		return o.answer, o.err
+	case <-ctx.Done():
+		if err := parent.Err(); err != nil {
+			return solver.Answer{}, err
+		}
+		return solver.Answer{}, fmt.Errorf("%w after %s", errTimeout, timeout)
+	}
+}
+
+// errPanic is the error for a solver that panicked.
+var errPanic = errors.New("panic")
+
+// recoverPanic calls f, turning a panic into an error so that one broken solver cannot stop the others.
+func recoverPanic(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", errPanic, r)
+		}
+	}()
+	return f()
+}
+
+// stdinName is the input file name that stands for standard input.
+const stdinName = "-"
+
+// parseFile parses the named input file, or standard input if the name is -, with the solver and returns the SHA-256
+// hash of the input.
+func parseFile(s solver.Solver, fileName string) (string, error) {
+	if fileName == stdinName {
+		input, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("reading standard input: %w", err)
+		}
+		return parseInput(s, "stdin", input)
+	}
+	input, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	return parseInput(s, fileName, input)
+}
+
+// parseInput parses an input, described by name in any errors, with the solver and returns its SHA-256 hash. The
+// line endings of the input are normalized first, so an input saved with CRLF line endings or a final newline has
+// the same hash and answers as one without.
+func parseInput(s solver.Solver, name string, input []byte) (string, error) {
+	input = parse.Normalize(input)
+	hash := sha256.Sum256(input)
+	return hex.EncodeToString(hash[:]), s.Parse(parse.NamedReader(name, bytes.NewReader(input)))
+}
+
+// formatAnswer converts an answer to a string for display.
+func formatAnswer(answer solver.Answer) string {
+	if answer.Kind() == solver.None {
+		return "-"
+	}
+	return answer.String()
+}
+
+// formatDuration rounds a duration for display.
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration >= time.Second:
+		return duration.Round(time.Millisecond).String()
+	case duration >= time.Millisecond:
+		return duration.Round(time.Microsecond).String()
+	}
+	return duration.String()
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// slowSolver solves part one only once its context is done, and never finishes part two.
+type slowSolver struct{}
+
+func (slowSolver) Parse(r io.Reader) error { return nil }
+
+func (slowSolver) PartOne(ctx context.Context) (solver.Answer, error) {
+	<-ctx.Done()
+	return solver.Answer{}, ctx.Err()
+}
+
+func (slowSolver) PartTwo(context.Context) (solver.Answer, error) {
+	select {}
+}
+
+func TestSolvePartTimesOut(t *testing.T) {
+	for part := 1; part <= 2; part++ {
+		_, err := solvePart(context.Background(), slowSolver{}, part, 10*time.Millisecond)
+		if !errors.Is(err, errTimeout) || err.Error() != "timed out after 10ms" {
+			t.Errorf("part %d: got error %v, want a timeout", part, err)
+		}
+	}
+}
+
+// sleepySolver answers each part with its number after sleeping for that many milliseconds, and panics in part one
+// if told to.
+type sleepySolver struct {
+	panics bool
+}
+
+func (*sleepySolver) Parse(r io.Reader) error { return nil }
+
+func (s *sleepySolver) PartOne(context.Context) (solver.Answer, error) {
+	if s.panics {
+		panic("broken")
+	}
+	time.Sleep(time.Millisecond)
+	return solver.IntAnswer(1), nil
+}
+
+func (*sleepySolver) PartTwo(context.Context) (solver.Answer, error) {
+	time.Sleep(2 * time.Millisecond)
+	return solver.IntAnswer(2), nil
+}
+
+func TestSolveDays(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(fileName, []byte("input\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	selected := []day{}
+	for number := 1; number <= 5; number++ {
+		selected = append(selected, day{Number: number, Parts: 2, New: func() solver.Solver {
+			return &sleepySolver{panics: number == 3}
+		}})
+	}
+	noParams := func(day) map[string]int { return nil }
+
+	results := solveDays(selected, 0, inputPaths(fileName), noParams, time.Second, 3, false, nil)
+	if len(results) != 10 {
+		t.Fatalf("got %d results, want 10", len(results))
+	}
+	for i, r := range results {
+		if r.Day != i/2+1 || r.Part != i%2+1 {
+			t.Errorf("result %d is for day %d part %d", i, r.Day, r.Part)
+		}
+		if r.Day == 3 && r.Part == 1 {
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "panic: broken") {
+				t.Errorf("day 3 part 1: got error %v, want the panic", r.Err)
+			}
+		} else if r.Err != nil || r.Answer.String() != strconv.Itoa(r.Part) {
+			t.Errorf("day %d part %d: got %v, %v", r.Day, r.Part, r.Answer, r.Err)
+		}
+	}
+}
+
+func TestPrepareDayValidatesParams(t *testing.T) {
+	tests := []struct {
+		day    int
+		params map[string]int
+		want   string
+	}{
+		{14, map[string]int{"width": 0}, "width must be from 1 to 1000, not 0"},
+		{18, map[string]int{"gridSize": 100000}, "gridSize must be from 1 to 999, not 100000"},
+		{11, map[string]int{"partTwoBlinks": -1}, "partTwoBlinks must be from 1 to 1000, not -1"},
+		{20, map[string]int{"cheatRadius": 0}, "cheatRadius must be from 1 to 1000, not 0"},
+		{22, map[string]int{"iterations": 0}, "iterations must be from 1 to 20000, not 0"},
+	}
+	for _, test := range tests {
+		d := days2024[test.day-1]
+		fileName := filepath.Join("..", "..", fmt.Sprintf("day-%02d", d.Number), "input.txt")
+		_, results := prepareDay(d, 0, fileName, test.params, false)
+		for _, r := range results {
+			if r.Err == nil || r.Err.Error() != test.want {
+				t.Errorf("day %d part %d with %v: got error %v, want %q", r.Day, r.Part, test.params, r.Err, test.want)
+			}
+		}
+	}
+}
+
+func TestBatchInputs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.txt", "a.txt", ".hidden.txt", "notes.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "more.txt"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	got, err := batchInputs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if _, err := batchInputs(t.TempDir()); err == nil {
+		t.Error("empty directory: got no error")
+	}
+}
+
+func TestSolveInputs(t *testing.T) {
+	input, err := os.ReadFile("../../day-02/test_data.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	files := map[string]string{
+		"crlf.txt":     strings.ReplaceAll(string(input), "\n", "\r\n") + "\r\n",
+		"lf.txt":       string(input),
+		"trailing.txt": string(input) + "\n",
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d, err := findDay(2024, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileNames, err := batchInputs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs := []job{}
+	for _, fileName := range fileNames {
+		jobs = append(jobs, job{day: d, input: fileName})
+	}
+
+	results := solveJobs(jobs, 0, func(day) map[string]int { return nil }, time.Minute, 2, false, nil,
+		events.Discard)
+	if len(results) != 6 {
+		t.Fatalf("got %d results, want 6", len(results))
+	}
+	for i, r := range results {
+		if r.Err != nil || r.Input != fileNames[i/2] || r.Answer.String() != strconv.Itoa(2*r.Part) {
+			t.Errorf("%s part %d: got %v, %v", r.Input, r.Part, r.Answer, r.Err)
+		}
+		if r.InputHash != results[0].InputHash {
+			t.Errorf("%s: got hash %s, want %s as for the other line endings", r.Input, r.InputHash,
+				results[0].InputHash)
+		}
+	}
+
+	if err := runCommand([]string{"2", "--input", dir, "--year", "2024", "--no-cache"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseStdin(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(fileName, []byte("7 6 4 2 1\r\n1 2 7 8 9\r\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	stdin := os.Stdin
+	os.Stdin = file
+	defer func() { os.Stdin = stdin }()
+
+	d, err := findDay(2024, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := solveDays([]day{d}, 1, inputPaths(stdinName), func(day) map[string]int { return nil }, time.Minute,
+		1, false, nil)
+	if r := results[0]; r.Err != nil || r.Input != stdinName || r.Answer.String() != "1" {
+		t.Errorf("got %+v", r)
+	}
+}
+
+func TestSolveJobsTrace(t *testing.T) {
+	if _, err := newTracer("xml", io.Discard); err == nil {
+		t.Error("xml: got no error")
+	}
+	var buffer bytes.Buffer
+	tracer, err := newTracer("json", &buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := findDay(2024, 17)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs := []job{{day: d, input: "../../day-17/test_data.txt"}, {day: d, input: "../../day-17/test_data_2.txt"}}
+	results := solveJobs(jobs, 1, func(day) map[string]int { return nil }, time.Minute, 1, false, nil, tracer)
+	if err := checkResults(results); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(buffer.String(), "\n")
+	want := `{"event":"execute","day":17,"part":1,"input":"../../day-17/test_data.txt","ip":0,"opcode":"adv",` +
+		`"operand":1,"a":729,"b":0,"c":0}`
+	if lines[0] != want {
+		t.Errorf("got first event %s, want %s", lines[0], want)
+	}
+	if !strings.Contains(buffer.String(), `"input":"../../day-17/test_data_2.txt"`) {
+		t.Error("no events for the second input")
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// serveCommand runs an HTTP server that solves puzzle inputs sent to it.
+func serveCommand(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	maxInput := flags.Int64("max-input", 1<<20, "largest puzzle input accepted, in bytes")
+	timeout := flags.Duration("timeout", 0, "time allowed for each request (defaults to each day's own budget)")
+	workers := flags.Int("workers", runtime.GOMAXPROCS(0), "number of parts to solve at the same time")
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc serve [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	if *maxInput < 1 {
+		return fmt.Errorf("invalid maximum input size %d", *maxInput)
+	}
+	if *workers < 1 {
+		return fmt.Errorf("invalid number of workers %d", *workers)
+	}
+	y, err := findYear(*yearNumber)
+	if err != nil {
+		return err
+	}
+	params, err := paramOptions.load(y.Number, y.Days)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(y.Days, params, *maxInput, *timeout, *workers),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("listening on %s", *addr)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// server answers requests to solve the parts of its days.
+type server struct {
+	days     []day
+	params   func(day) map[string]int
+	maxInput int64
+	timeout  time.Duration
+	workers  chan struct{} // holds a token for each part being solved
+}
+
+// newServer returns a handler for the HTTP API. Each day starts from the given parameters, which a request can
+// override. Inputs may be at most maxInput bytes, each part may take up to timeout, or its day's budget if timeout is
+// zero, and at most workers parts are solved at the same time.
+func newServer(days []day, params func(day) map[string]int, maxInput int64, timeout time.Duration,
+	workers int) http.Handler {
+	s := &server{
+		days:     days,
+		params:   params,
+		maxInput: maxInput,
+		timeout:  timeout,
+		workers:  make(chan struct{}, workers),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /days", s.listDays)
+	mux.HandleFunc("POST /days/{day}/parts/{part}", s.solve)
+	return mux
+}
+
+// dayInfo describes a day in the response to GET /days.
+type dayInfo struct {
+	Day    int         `json:"day"`
+	Parts  int         `json:"parts"`
+	BigInt bool        `json:"bigInt"`
+	Params []paramInfo `json:"params"`
+}
+
+// paramInfo describes a parameter of a day and the value it takes unless a request overrides it.
+type paramInfo struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+// listDays responds with the days the server can solve.
+func (s *server) listDays(w http.ResponseWriter, r *http.Request) {
+	infos := []dayInfo{}
+	for _, d := range s.days {
+		sol := d.New()
+		if err := solver.SetParams(sol, s.params(d)); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		_, bigInt := sol.(solver.BigSolver)
+		info := dayInfo{Day: d.Number, Parts: d.Parts, BigInt: bigInt, Params: []paramInfo{}}
+		for _, param := range solver.Params(sol) {
+			info.Params = append(info.Params, paramInfo{Name: param.Name, Value: param.Value})
+		}
+		infos = append(infos, info)
+	}
+	writeJSONResponse(w, http.StatusOK, infos)
+}
+
+// solve responds with the answer to one part of a day for the puzzle input in the request body. The query sets the
+// day's parameters, as name=value, and bigint=true switches the day to big integers if it can use them.
+func (s *server) solve(w http.ResponseWriter, r *http.Request) {
+	d, part, err := s.findPart(r.PathValue("day"), r.PathValue("part"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	params, bigInt, err := s.requestParams(d, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	sol := d.New()
+	if b, ok := sol.(solver.BigSolver); ok {
+		b.SetBigInt(bigInt)
+	}
+	if err := solver.SetParams(sol, params); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	input, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxInput))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("input is larger than %d bytes", s.maxInput))
+		} else {
+			writeError(w, http.StatusBadRequest, err)
+		}
+		return
+	}
+
+	// The token is given back once the solver stops rather than when the request ends, so that solvers which carry on
+	// after their timeout still count towards the workers.
+	select {
+	case s.workers <- struct{}{}:
+	case <-r.Context().Done():
+		return
+	}
+	release := func() { <-s.workers }
+
+	res := result{Day: d.Number, Part: part}
+	err = recoverPanic(func() (err error) {
+		res.InputHash, err = parseInput(sol, "input", input)
+		return err
+	})
+	if err != nil {
+		release()
+		status := http.StatusBadRequest
+		if errors.Is(err, errPanic) {
+			status = http.StatusInternalServerError
+		}
+		writeError(w, status, err)
+		return
+	}
+
+	timeout := s.timeout
+	if timeout == 0 {
+		timeout = d.budget()
+	}
+	start := time.Now()
+	res.Answer, res.Err = solvePartThen(r.Context(), sol, part, timeout, release)
+	res.Elapsed = time.Since(start)
+	if r.Context().Err() != nil {
+		return
+	}
+	if _, ok := sol.(solver.BigSolver); ok && !bigInt && errors.Is(res.Err, checked.ErrOverflow) {
+		res.Err = fmt.Errorf("%w (retry with bigint=true)", res.Err)
+	}
+	writeJSONResponse(w, resultStatus(res.Err), newRecord(res))
+}
+
+// findPart returns the day and part named in a request path.
+func (s *server) findPart(dayName, partName string) (day, int, error) {
+	number, err := strconv.Atoi(dayName)
+	if err != nil {
+		return day{}, 0, fmt.Errorf("invalid day %q", dayName)
+	}
+	var d day
+	found := false
+	for _, candidate := range s.days {
+		if candidate.Number == number {
+			d, found = candidate, true
+		}
+	}
+	if !found {
+		return day{}, 0, fmt.Errorf("no solution for day %d", number)
+	}
+	part, err := strconv.Atoi(partName)
+	if err != nil {
+		return day{}, 0, fmt.Errorf("invalid part %q", partName)
+	}
+	if part < 1 || part > d.Parts {
+		return day{}, 0, fmt.Errorf("day %d: %w", d.Number, solver.ErrNoPart)
+	}
+	return d, part, nil
+}
+
+// requestParams returns the day's parameters with the overrides from the request query, along with whether the
+// request asked for big integers.
+func (s *server) requestParams(d day, r *http.Request) (map[string]int, bool, error) {
+	params := map[string]int{}
+	for name, value := range s.params(d) {
+		params[name] = value
+	}
+	bigInt := false
+	for name, values := range r.URL.Query() {
+		raw := values[len(values)-1]
+		if name == "bigint" {
+			var err error
+			if bigInt, err = strconv.ParseBool(raw); err != nil {
+				return nil, false, fmt.Errorf("invalid bigint %q", raw)
+			}
+			continue
+		}
+		if err := checkParam(d, name); err != nil {
+			return nil, false, err
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid value %q for parameter %q", raw, name)
+		}
+		params[name] = value
+	}
+	return params, bigInt, nil
+}
+
+// resultStatus returns the HTTP status for the outcome of solving a part.
+func resultStatus(err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, errTimeout):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, errPanic):
+		return http.StatusInternalServerError
+	}
+	return http.StatusUnprocessableEntity
+}
+
+// writeError responds with an error message.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSONResponse(w, status, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+// writeJSONResponse responds with a value encoded as JSON.
+func writeJSONResponse(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("writing response: %v", err)
+	}
+}
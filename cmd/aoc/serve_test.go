@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// dayOneInput is the example input from the day 1 puzzle.
+const dayOneInput = "3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n"
+
+// noParams is the parameter source for a server that leaves every day at its defaults.
+func noParams(day) map[string]int { return nil }
+
+// post sends a puzzle input to the server and decodes the JSON response.
+func post(t *testing.T, handler http.Handler, path, input string) (int, record) {
+	t.Helper()
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodPost, path, strings.NewReader(input)))
+	var rec record
+	if err := json.NewDecoder(response.Body).Decode(&rec); err != nil {
+		t.Fatalf("%s: decoding response: %v", path, err)
+	}
+	return response.Code, rec
+}
+
+func TestServeSolve(t *testing.T) {
+	handler := newServer(days2024, noParams, 1<<20, time.Minute, 2)
+
+	status, rec := post(t, handler, "/days/1/parts/1", dayOneInput)
+	if status != http.StatusOK || rec.Answer != "11" || rec.AnswerType != "int" || rec.Error != "" {
+		t.Errorf("day 1 part 1: got status %d and %+v, want answer 11", status, rec)
+	}
+	if rec.Day != 1 || rec.Part != 1 || len(rec.InputHash) != 64 {
+		t.Errorf("day 1 part 1: got %+v, want the day, part and input hash", rec)
+	}
+
+	input, err := os.ReadFile("../../day-18/test_data.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, rec = post(t, handler, "/days/18/parts/2?gridSize=6&simulatedBytes=12", string(input))
+	if status != http.StatusOK || rec.Answer != "6,1" {
+		t.Errorf("day 18 part 2: got status %d and %+v, want answer 6,1", status, rec)
+	}
+}
+
+func TestServeRejectsRequests(t *testing.T) {
+	handler := newServer(days2024, noParams, 64, time.Minute, 1)
+
+	tests := []struct {
+		path, input string
+		status      int
+	}{
+		{"/days/26/parts/1", dayOneInput, http.StatusNotFound},
+		{"/days/one/parts/1", dayOneInput, http.StatusNotFound},
+		{"/days/25/parts/2", dayOneInput, http.StatusNotFound},
+		{"/days/1/parts/1?size=3", dayOneInput, http.StatusBadRequest},
+		{"/days/18/parts/1?gridSize=big", dayOneInput, http.StatusBadRequest},
+		{"/days/18/parts/1?gridSize=100000", dayOneInput, http.StatusBadRequest},
+		{"/days/14/parts/1?width=0", dayOneInput, http.StatusBadRequest},
+		{"/days/1/parts/1?bigint=maybe", dayOneInput, http.StatusBadRequest},
+		{"/days/1/parts/1", "3 four\n", http.StatusBadRequest},
+		{"/days/1/parts/1", strings.Repeat(dayOneInput, 4), http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		status, rec := post(t, handler, test.path, test.input)
+		if status != test.status || rec.Error == "" {
+			t.Errorf("%s: got status %d and %+v, want status %d with an error", test.path, status, rec, test.status)
+		}
+	}
+}
+
+func TestServeTimesOut(t *testing.T) {
+	slow := []day{{Number: 1, Parts: 2, New: func() solver.Solver { return slowSolver{} }}}
+	handler := newServer(slow, noParams, 64, 10*time.Millisecond, 1)
+
+	status, rec := post(t, handler, "/days/1/parts/1", "")
+	if status != http.StatusGatewayTimeout || rec.Error != "timed out after 10ms" {
+		t.Errorf("got status %d and %+v, want a timeout", status, rec)
+	}
+}
+
+func TestServeHoldsWorkersForStuckSolvers(t *testing.T) {
+	slow := []day{{Number: 1, Parts: 2, New: func() solver.Solver { return slowSolver{} }}}
+	handler := newServer(slow, noParams, 64, 10*time.Millisecond, 1)
+
+	// Part two never stops, so it keeps the only worker after its request has timed out.
+	if status, rec := post(t, handler, "/days/1/parts/2", ""); status != http.StatusGatewayTimeout {
+		t.Fatalf("part 2: got status %d and %+v, want a timeout", status, rec)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/days/1/parts/1", nil).WithContext(ctx)
+	handler.ServeHTTP(response, request)
+	if response.Body.Len() != 0 {
+		t.Errorf("part 1: got response %q, want the request to wait for a worker until it is cancelled", response.Body)
+	}
+}
+
+func TestServeConcurrently(t *testing.T) {
+	handler := newServer(days2024, noParams, 1<<20, time.Minute, 2)
+
+	var wg sync.WaitGroup
+	for part := 1; part <= 2; part++ {
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				want := map[int]string{1: "11", 2: "31"}[part]
+				path := "/days/1/parts/" + strconv.Itoa(part)
+				if status, rec := post(t, handler, path, dayOneInput); status != http.StatusOK || rec.Answer != want {
+					t.Errorf("part %d: got status %d and %+v, want answer %s", part, status, rec, want)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func TestServeListsDays(t *testing.T) {
+	handler := newServer(days2024, func(d day) map[string]int {
+		if d.Number == 18 {
+			return map[string]int{"gridSize": 6}
+		}
+		return nil
+	}, 64, time.Minute, 1)
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/days", nil))
+	if response.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", response.Code, http.StatusOK)
+	}
+	var infos []dayInfo
+	if err := json.NewDecoder(response.Body).Decode(&infos); err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != len(days2024) {
+		t.Fatalf("got %d days, want %d", len(infos), len(days2024))
+	}
+	day18 := infos[17]
+	want := []paramInfo{{Name: "gridSize", Value: 6}, {Name: "simulatedBytes", Value: 1024}}
+	if day18.Day != 18 || day18.Parts != 2 || day18.BigInt || len(day18.Params) != 2 ||
+		day18.Params[0] != want[0] || day18.Params[1] != want[1] {
+		t.Errorf("got %+v for day 18, want parameters %+v", day18, want)
+	}
+	if !infos[6].BigInt {
+		t.Errorf("got %+v for day 7, want it to support big integers", infos[6])
+	}
+
+	response = httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodPost, "/days", nil))
+	if response.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /days: got status %d, want %d", response.Code, http.StatusMethodNotAllowed)
+	}
+}
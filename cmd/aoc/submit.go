@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/internal/client"
+	"github.com/markcooper37/aoc-2024/internal/ledger"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// submitCommand solves one part of a day and submits the answer, unless the ledger shows that it cannot be right.
+func submitCommand(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ContinueOnError)
+	clientOptions := addClientFlags(flags)
+	yearNumber := addYearFlag(flags)
+	paramOptions := addParamFlags(flags)
+	input := flags.String("input", "", "path to the puzzle input (defaults to the day's input file)")
+	bigInt := flags.Bool("bigint", false, "use big integers if the day's answer can outgrow an int")
+	noCache := flags.Bool("no-cache", false, "solve the part, without reading or writing a cached answer")
+	ledgerFile := flags.String("ledger", "", "file recording submitted answers (default ledger.json in the cache directory)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc submit <day> <part> [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	number, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid day %q", positional[0])
+	}
+	d, err := findDay(*yearNumber, number)
+	if err != nil {
+		return err
+	}
+	part, err := strconv.Atoi(positional[1])
+	if err != nil || part < 1 || part > 2 {
+		return fmt.Errorf("invalid part %q", positional[1])
+	}
+	if part > d.Parts {
+		return fmt.Errorf("day %d: %w", d.Number, solver.ErrNoPart)
+	}
+
+	params, err := paramOptions.load(d.Year, []day{d})
+	if err != nil {
+		return err
+	}
+	solved := solveDays([]day{d}, part, inputPaths(*input), params, 0, 1, *bigInt,
+		resultCache(*clientOptions.cacheDir, *noCache))[0]
+	if solved.Err != nil {
+		return solved.Err
+	}
+	if solved.Answer.Kind() == solver.None {
+		return fmt.Errorf("day %d part %d has no answer to submit", d.Number, part)
+	}
+	answer := solved.Answer.String()
+
+	if *ledgerFile == "" {
+		*ledgerFile = filepath.Join(*clientOptions.cacheDir, "ledger.json")
+	}
+	answers, err := ledger.Open(*ledgerFile)
+	if err != nil {
+		return err
+	}
+	if err := answers.Check(d.Year, d.Number, part, answer, time.Now()); err != nil {
+		return err
+	}
+
+	c, err := clientOptions.client()
+	if err != nil {
+		return err
+	}
+	response, err := c.Submit(context.Background(), d.Year, d.Number, part, answer)
+	if err != nil {
+		return clientOptions.explain(err)
+	}
+
+	attempt := ledger.Attempt{Year: d.Year, Day: d.Number, Part: part, Answer: answer, Verdict: response.Verdict,
+		Time: time.Now()}
+	if response.Wait > 0 {
+		retryAfter := attempt.Time.Add(response.Wait)
+		attempt.RetryAfter = &retryAfter
+	}
+	if response.Verdict != client.AlreadySolved {
+		if err := answers.Record(attempt); err != nil {
+			return err
+		}
+	}
+
+	switch response.Verdict {
+	case client.Correct:
+		fmt.Printf("day %d part %d: %s is correct\n", d.Number, part, answer)
+		return nil
+	case client.Unknown:
+		return fmt.Errorf("day %d part %d: could not understand the response: %s", d.Number, part, response.Message)
+	case client.RateLimited:
+		return fmt.Errorf("day %d part %d: answer not checked, try again in %s", d.Number, part, response.Wait)
+	case client.AlreadySolved:
+		return fmt.Errorf("day %d part %d: already solved", d.Number, part)
+	}
+	return fmt.Errorf("day %d part %d: %s is %s", d.Number, part, answer, response.Verdict)
+}
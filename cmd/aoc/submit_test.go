@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/internal/ledger"
+)
+
+func TestSubmitCommand(t *testing.T) {
+	var submissions atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /2024/day/2/answer", func(w http.ResponseWriter, r *http.Request) {
+		submissions.Add(1)
+		fmt.Fprint(w, "<article><p>That's not the right answer; your answer is too low.</p></article>")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Setenv("AOC_SESSION", "secret")
+	args := []string{"2", "1", "--input", "../../day-02/test_data.txt", "--url", server.URL, "--cache", t.TempDir(),
+		"--interval", "0s", "--year", "2024"}
+	err := submitCommand(args)
+	if err == nil || !strings.Contains(err.Error(), "2 is too low") {
+		t.Errorf("got error %v, want the answer reported as too low", err)
+	}
+
+	// The ledger now knows the answer is wrong, so it is not sent again.
+	if err := submitCommand(args); !errors.Is(err, ledger.ErrRejected) {
+		t.Errorf("got error %v, want %v", err, ledger.ErrRejected)
+	}
+	if n := submissions.Load(); n != 1 {
+		t.Errorf("made %d submissions, want 1", n)
+	}
+}
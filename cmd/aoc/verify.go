@@ -0,0 +1,218 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+
+	"github.com/markcooper37/aoc-2024/internal/snapshot"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// defaultSnapshotFile is the snapshot of answers that verify compares against, relative to the repository root.
+const defaultSnapshotFile = "answers.json"
+
+// verifyCommand reruns the days and compares their answers with the snapshot, or records them in it with --update.
+func verifyCommand(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	yearNumber := addYearFlag(flags)
+	part := flags.Int("part", 0, "verify only the given part (1 or 2)")
+	input := flags.String("input", "", "path to the puzzle input (defaults to the day's input file)")
+	snapshotFile := flags.String("snapshot", defaultSnapshotFile, "file recording the expected answers")
+	update := flags.Bool("update", false, "record the answers in the snapshot instead of failing on differences")
+	timeout := flags.Duration("timeout", 0, "time allowed for each part (defaults to each day's own budget)")
+	workers := flags.Int("workers", runtime.GOMAXPROCS(0), "number of parts to run at the same time")
+	paramOptions := addParamFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc verify [day|all] [flags]")
+		flags.PrintDefaults()
+	}
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+	if *workers < 1 {
+		return fmt.Errorf("invalid number of workers %d", *workers)
+	}
+	if *timeout < 0 {
+		return fmt.Errorf("invalid timeout %s", *timeout)
+	}
+	selected, err := selectDays(*yearNumber, positional)
+	if err != nil {
+		return err
+	}
+	if len(selected) > 1 && *input != "" {
+		return errors.New("--input cannot be used when verifying all days")
+	}
+	params, err := paramOptions.load(*yearNumber, selected)
+	if err != nil {
+		return err
+	}
+
+	answers, err := snapshot.Open(*snapshotFile, *update)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w (run with --update to record the answers)", err)
+	} else if err != nil {
+		return err
+	}
+	dayParams := map[int]map[string]int{}
+	for _, d := range selected {
+		dayParams[d.Number] = changedParams(d, params(d))
+	}
+	results := solveDays(selected, *part, inputPaths(*input), params, *timeout, *workers, false, nil)
+	checks := compareAnswers(answers, *yearNumber, results, dayParams)
+	if *update {
+		for _, c := range checks {
+			if c.status == changed || c.status == unrecorded {
+				answers.Set(snapshot.Entry{Year: *yearNumber, Day: c.result.Day, Part: c.result.Part,
+					InputHash: c.result.InputHash, Params: dayParams[c.result.Day], Answer: c.result.Answer.String()})
+			}
+		}
+		if err := answers.Save(); err != nil {
+			return err
+		}
+	}
+	if err := writeChecks(os.Stdout, checks, *update); err != nil {
+		return err
+	}
+	return checkFailures(checks, *snapshotFile, *update)
+}
+
+// changedParams returns the parameters of a day that differ from the defaults of its solver, or nil if there are none.
+func changedParams(d day, params map[string]int) map[string]int {
+	defaults := map[string]int{}
+	for _, param := range solver.Params(d.New()) {
+		defaults[param.Name] = param.Value
+	}
+	var changed map[string]int
+	for name, value := range params {
+		if defaults[name] != value {
+			if changed == nil {
+				changed = map[string]int{}
+			}
+			changed[name] = value
+		}
+	}
+	return changed
+}
+
+// checkStatus is the outcome of comparing the answer to a part with the snapshot.
+type checkStatus int
+
+const (
+	matched    checkStatus = iota // the answer is the recorded one
+	changed                       // the answer differs from the recorded one
+	unrecorded                    // there is no recorded answer for the part and input
+	failed                        // the part could not be solved
+	unsolved                      // the part is a stub that has not been solved yet
+)
+
+// check is the result of a part along with how it compares with the snapshot.
+type check struct {
+	result   result
+	status   checkStatus
+	recorded string // the recorded answer, if there is one
+}
+
+// compareAnswers compares the results for the days of a year, run with the given parameters that differ from each
+// day's defaults, with the answers recorded in the snapshot.
+func compareAnswers(answers *snapshot.Snapshot, yearNumber int, results []result,
+	dayParams map[int]map[string]int) []check {
+	checks := []check{}
+	for _, r := range results {
+		c := check{result: r}
+		switch {
+		case errors.Is(r.Err, solver.ErrUnsolved):
+			c.status = unsolved
+		case r.Err != nil:
+			c.status = failed
+		default:
+			recorded, ok := answers.Lookup(yearNumber, r.Day, r.Part, r.InputHash, dayParams[r.Day])
+			switch {
+			case !ok:
+				c.status = unrecorded
+			case recorded != r.Answer.String():
+				c.status, c.recorded = changed, recorded
+			default:
+				c.status, c.recorded = matched, recorded
+			}
+		}
+		checks = append(checks, c)
+	}
+	return checks
+}
+
+// writeChecks writes a line for every part that does not match the snapshot, followed by a count of the parts in
+// each state. In update mode, changed and unrecorded answers are reported as having been recorded.
+func writeChecks(w io.Writer, checks []check, update bool) error {
+	counts := map[checkStatus]int{}
+	for _, c := range checks {
+		counts[c.status]++
+		r := c.result
+		var err error
+		switch c.status {
+		case changed:
+			if update {
+				_, err = fmt.Fprintf(w, "day %d part %d: updated %s to %s\n", r.Day, r.Part, c.recorded,
+					formatAnswer(r.Answer))
+			} else {
+				_, err = fmt.Fprintf(w, "day %d part %d: got %s, want %s\n", r.Day, r.Part, formatAnswer(r.Answer),
+					c.recorded)
+			}
+		case unrecorded:
+			if update {
+				_, err = fmt.Fprintf(w, "day %d part %d: recorded %s\n", r.Day, r.Part, formatAnswer(r.Answer))
+			} else {
+				_, err = fmt.Fprintf(w, "day %d part %d: got %s, but no answer is recorded for input %.12s\n", r.Day,
+					r.Part, formatAnswer(r.Answer), r.InputHash)
+			}
+		case failed:
+			_, err = fmt.Fprintf(w, "day %d part %d: error: %v\n", r.Day, r.Part, r.Err)
+		case unsolved:
+			_, err = fmt.Fprintf(w, "day %d part %d: not solved yet\n", r.Day, r.Part)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	format := "%d matched, %d changed, %d unrecorded, %d failed, %d unsolved\n"
+	if update {
+		format = "%d matched, %d updated, %d recorded, %d failed, %d unsolved\n"
+	}
+	_, err := fmt.Fprintf(w, format, counts[matched], counts[changed], counts[unrecorded], counts[failed],
+		counts[unsolved])
+	return err
+}
+
+// checkFailures returns an error if any part failed, or, outside update mode, differed from the snapshot.
+func checkFailures(checks []check, snapshotFile string, update bool) error {
+	failures, differences := 0, 0
+	for _, c := range checks {
+		switch c.status {
+		case failed:
+			failures++
+		case changed, unrecorded:
+			differences++
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d parts failed", failures, len(checks))
+	}
+	if differences > 0 && !update {
+		return fmt.Errorf("%d of %d parts differ from %s (rerun with --update to record them)", differences,
+			len(checks), snapshotFile)
+	}
+	return nil
+}
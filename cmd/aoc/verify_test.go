@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/internal/snapshot"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+func TestCompareAnswers(t *testing.T) {
+	answers, err := snapshot.Open(filepath.Join(t.TempDir(), "answers.json"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answers.Set(snapshot.Entry{Year: 2024, Day: 1, Part: 1, InputHash: "aaaa", Answer: "11"})
+	answers.Set(snapshot.Entry{Year: 2024, Day: 1, Part: 2, InputHash: "aaaa", Answer: "31"})
+
+	results := []result{
+		{Day: 1, Part: 1, Answer: solver.IntAnswer(11), InputHash: "aaaa"},
+		{Day: 1, Part: 2, Answer: solver.IntAnswer(32), InputHash: "aaaa"},
+		{Day: 2, Part: 1, Answer: solver.IntAnswer(2), InputHash: "bbbb"},
+		{Day: 2, Part: 2, Err: errors.New("broken"), InputHash: "bbbb"},
+		{Day: 3, Part: 1, Err: solver.ErrUnsolved, InputHash: "cccc"},
+	}
+	checks := compareAnswers(answers, 2024, results, nil)
+	want := []checkStatus{matched, changed, unrecorded, failed, unsolved}
+	for i, c := range checks {
+		if c.status != want[i] {
+			t.Errorf("day %d part %d: got status %d, want %d", c.result.Day, c.result.Part, c.status, want[i])
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := writeChecks(&buffer, checks, false); err != nil {
+		t.Fatal(err)
+	}
+	wantReport := `day 1 part 2: got 32, want 31
+day 2 part 1: got 2, but no answer is recorded for input bbbb
+day 2 part 2: error: broken
+day 3 part 1: not solved yet
+1 matched, 1 changed, 1 unrecorded, 1 failed, 1 unsolved
+`
+	if got := buffer.String(); got != wantReport {
+		t.Errorf("got report %q, want %q", got, wantReport)
+	}
+	if err := checkFailures(checks, "answers.json", false); err == nil || err.Error() != "1 of 5 parts failed" {
+		t.Errorf("got error %v, want one part reported as failed", err)
+	}
+	if err := checkFailures(checks[:3], "answers.json", true); err != nil {
+		t.Errorf("update: got error %v, want none", err)
+	}
+}
+
+func TestVerifyCommand(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "answers.json")
+	args := []string{"2", "--input", "../../day-02/test_data.txt", "--snapshot", fileName, "--year", "2024"}
+	if err := verifyCommand(args); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing snapshot: got error %v, want %v", err, os.ErrNotExist)
+	}
+	if err := verifyCommand(append(args, "--update")); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := verifyCommand(args); err != nil {
+		t.Errorf("after update: got error %v", err)
+	}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := strings.Replace(string(data), `"answer": "2"`, `"answer": "3"`, 1)
+	if tampered == string(data) {
+		t.Fatalf("snapshot does not record an answer of 2:\n%s", data)
+	}
+	if err := os.WriteFile(fileName, []byte(tampered), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyCommand(args); err == nil || !strings.Contains(err.Error(), "1 of 2 parts differ") {
+		t.Errorf("tampered snapshot: got error %v, want one part to differ", err)
+	}
+}
+
+func TestVerifyCommandParams(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "answers.json")
+	args := []string{"18", "--input", "../../day-18/test_data.txt", "--snapshot", fileName, "--year", "2024",
+		"--part", "1", "--param", "gridSize=6"}
+	if err := verifyCommand(append(args, "--param", "simulatedBytes=12", "--update")); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := verifyCommand(append(args, "--param", "simulatedBytes=11")); err == nil ||
+		!strings.Contains(err.Error(), "1 of 1 parts differ") {
+		t.Errorf("other parameters: got error %v, want the answer to be unrecorded", err)
+	}
+	if err := verifyCommand(append(args, "--param", "simulatedBytes=11", "--update")); err != nil {
+		t.Fatalf("update with other parameters: %v", err)
+	}
+	if err := verifyCommand(append(args, "--param", "simulatedBytes=12")); err != nil {
+		t.Errorf("first parameters after recording the others: got error %v", err)
+	}
+
+	answers, err := snapshot.Open(fileName, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(answers.Entries) != 2 || answers.Entries[0].Params["gridSize"] != 6 {
+		t.Errorf("got entries %+v, want one for each set of parameters", answers.Entries)
+	}
+}
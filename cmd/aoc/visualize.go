@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// animation parses the input for a day and returns its animation of a part.
+func animation(d day, part int, fileName string, params map[string]int) (iter.Seq[animate.Frame], error) {
+	s := d.New()
+	animator, ok := s.(animate.Animator)
+	if !ok {
+		return nil, fmt.Errorf("day %d has no animation", d.Number)
+	}
+	if part > d.Parts {
+		return nil, fmt.Errorf("day %d: %w", d.Number, solver.ErrNoPart)
+	}
+	if err := solver.SetParams(s, params); err != nil {
+		return nil, err
+	}
+	if _, err := parseFile(s, fileName); err != nil {
+		return nil, err
+	}
+	frames, err := animator.Animate(part)
+	if err != nil {
+		return nil, fmt.Errorf("day %d part %d: %w", d.Number, part, err)
+	}
+	return frames, nil
+}
+
+// play plays an animation in the terminal, showing each frame for delay until the speed is changed.
+func play(frames iter.Seq[animate.Frame], delay time.Duration) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	player := &animate.Player{Delay: delay}
+	if restore, err := readKeys(); err == nil {
+		defer restore()
+		player.Keys = keys()
+	}
+	if err := player.Play(ctx, os.Stdout, frames); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+// export writes an animation to a file: the last frame as a PNG image if the file name ends in .png, or every nth
+// frame as an animated GIF, each shown for delay, if it ends in .gif. The frames are written straight to the file as
+// they arrive, and the file is removed if the animation cannot be written.
+func export(frames iter.Seq[animate.Frame], fileName string, scale int, delay time.Duration, every int) (err error) {
+	if err := checkExportFile(fileName); err != nil {
+		return err
+	}
+	var last *animate.Frame
+	if strings.ToLower(filepath.Ext(fileName)) == ".png" {
+		for frame := range frames {
+			last = &frame
+		}
+		if last == nil {
+			return errors.New("no frames to write")
+		}
+	}
+
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(fileName)
+		}
+	}()
+	if last != nil {
+		return animate.WritePNG(file, *last, scale)
+	}
+	return animate.WriteGIF(file, frames, scale, delay, every)
+}
+
+// checkExportFile returns an error if an animation cannot be exported to the named file.
+func checkExportFile(fileName string) error {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".png", ".gif":
+		return nil
+	}
+	return fmt.Errorf("cannot export to %s: the file name must end in .png or .gif", fileName)
+}
+
+// readKeys switches the terminal to deliver each key as it is pressed, without echoing it, and returns a function
+// that restores the previous settings. It fails if standard input is not a terminal.
+func readKeys() (func(), error) {
+	settings, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stty("-icanon", "-echo", "min", "1"); err != nil {
+		return nil, err
+	}
+	return func() { stty(settings) }, nil
+}
+
+// stty runs the stty command on the terminal connected to standard input and returns its output.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	output, err := cmd.Output()
+	return strings.TrimSpace(string(output)), err
+}
+
+// keys delivers the bytes read from standard input, until it can no longer be read.
+func keys() <-chan byte {
+	pressed := make(chan byte)
+	go func() {
+		buffer := make([]byte, 1)
+		for {
+			if _, err := os.Stdin.Read(buffer); err != nil {
+				return
+			}
+			pressed <- buffer[0]
+		}
+	}()
+	return pressed
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"image/gif"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+func TestExportLongAnimation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping a long animation in short mode")
+	}
+	// Each frame is a 200x200 image, so holding all 2000 of them would take 80MB.
+	const frameCount, size, scale = 2000, 50, 4
+	peak := uint64(0)
+	frames := func(yield func(animate.Frame) bool) {
+		for i := range frameCount {
+			g := grid.New(size, size, byte('.'))
+			g.Set(grid.Point{Row: i / size % size, Col: i % size}, '#')
+			if !yield(animate.Frame{Grid: g}) {
+				return
+			}
+			if i%100 == 0 {
+				runtime.GC()
+				var stats runtime.MemStats
+				runtime.ReadMemStats(&stats)
+				peak = max(peak, stats.HeapAlloc)
+			}
+		}
+	}
+
+	var before runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	fileName := filepath.Join(t.TempDir(), "long.gif")
+	if err := export(frames, fileName, scale, 10*time.Millisecond, 1); err != nil {
+		t.Fatal(err)
+	}
+	if grown := int64(peak) - int64(before.HeapAlloc); grown > 10<<20 {
+		t.Errorf("the heap grew by %dMB while exporting", grown>>20)
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	animation, err := gif.DecodeAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(animation.Image) != frameCount {
+		t.Errorf("got %d frames, want %d", len(animation.Image), frameCount)
+	}
+}
+
+func TestExportInvalid(t *testing.T) {
+	dir := t.TempDir()
+	if err := export(func(func(animate.Frame) bool) {}, filepath.Join(dir, "empty.gif"), 1, time.Second, 1); err == nil {
+		t.Error("exported an animation with no frames")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "empty.gif")); err == nil {
+		t.Error("left a file behind for an animation that could not be exported")
+	}
+	if err := export(func(func(animate.Frame) bool) {}, filepath.Join(dir, "frames.txt"), 1, time.Second, 1); err == nil {
+		t.Error("exported to a file that is not an image")
+	}
+}
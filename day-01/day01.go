@@ -0,0 +1,132 @@
+package day01
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 1.
+type Solver struct {
+	firstColumn  []int
+	secondColumn []int
+}
+
+// New creates a solver for the puzzle for day 1.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	firstColumn, secondColumn, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.firstColumn, s.secondColumn = firstColumn, secondColumn
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.firstColumn, s.secondColumn)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.firstColumn, s.secondColumn)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, firstColumn, secondColumn []int) (int, error) {
+	firstColumn, secondColumn = slices.Sorted(slices.Values(firstColumn)), slices.Sorted(slices.Values(secondColumn))
+	total := 0
+	for i, value := range firstColumn {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		total += difference(value, secondColumn[i])
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, firstColumn, secondColumn []int) (int, error) {
+	firstColumnMap, secondColumnMap := map[int]int{}, map[int]int{}
+	for _, value := range firstColumn {
+		firstColumnMap[value]++
+	}
+
+	for _, value := range secondColumn {
+		secondColumnMap[value]++
+	}
+
+	total := 0
+	for key, value := range firstColumnMap {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		total += key * value * secondColumnMap[key]
+	}
+	return total, nil
+}
+
+// difference returns the difference between two integers.
+func difference(x, y int) int {
+	diff := x - y
+	if diff < 0 {
+		return -diff
+	}
+	return diff
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]int, []int, error) {
+	scanner := parse.NewScanner(r)
+
+	firstColumn, secondColumn := []int{}, []int{}
+	for scanner.Scan() {
+		pair := scanner.Line().Fields()
+		if len(pair) != 2 {
+			return nil, nil, scanner.Line().Invalid("two numbers separated by spaces")
+		}
+
+		first, err := pair[0].Int()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		firstColumn = append(firstColumn, first)
+
+		second, err := pair[1].Int()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		secondColumn = append(secondColumn, second)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return firstColumn, secondColumn, nil
+}
@@ -0,0 +1,41 @@
+package day01
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 1.
+type Generator struct {
+	// Lines is the number of pairs of location IDs.
+	Lines int `param:"lines"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Lines: 1000}
+}
+
+// Generate writes a random puzzle input.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Lines < 1 {
+		return fmt.Errorf("lines must be at least 1, not %d", g.Lines)
+	}
+
+	var b strings.Builder
+	firstColumn := []int{}
+	for i := 0; i < g.Lines; i++ {
+		first := 10000 + rng.IntN(90000)
+		firstColumn = append(firstColumn, first)
+		second := 10000 + rng.IntN(90000)
+		if rng.IntN(3) == 0 {
+			// Reuse an ID from the left list so that part two finds some similarity.
+			second = firstColumn[rng.IntN(len(firstColumn))]
+		}
+		fmt.Fprintf(&b, "%d   %d\n", first, second)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,145 @@
+package day02
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 2.
+type Solver struct {
+	reports [][]int
+}
+
+// New creates a solver for the puzzle for day 2.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	reports, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.reports = reports
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.reports)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.reports)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, reports [][]int) (int, error) {
+	safeCount := 0
+	for _, report := range reports {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if isSafe(report) {
+			safeCount++
+		}
+	}
+
+	return safeCount, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, reports [][]int) (int, error) {
+	safeCount := 0
+	for _, report := range reports {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if isSafe(report) {
+			safeCount++
+			continue
+		}
+		for i := range report {
+			firstPart, secondPart := make([]int, i), make([]int, len(report)-i-1)
+			copy(firstPart, report[:i])
+			copy(secondPart, report[i+1:])
+			reducedReport := append(firstPart, secondPart...)
+			if isSafe(reducedReport) {
+				safeCount++
+				break
+			}
+		}
+	}
+
+	return safeCount, nil
+}
+
+// isSafe checks if a report is safe.
+func isSafe(report []int) bool {
+	inc := false
+	for i := 0; i < len(report)-1; i++ {
+		if report[i] == report[i+1] {
+			return false
+		} else if difference(report[i], report[i+1]) > 3 {
+			return false
+		}
+		if i == 0 {
+			inc = report[0] < report[1]
+		} else {
+			if (report[i] < report[i+1]) != inc {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// difference returns the difference between two integers.
+func difference(x, y int) int {
+	diff := x - y
+	if diff < 0 {
+		return -diff
+	}
+	return diff
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([][]int, error) {
+	scanner := parse.NewScanner(r)
+
+	reports := [][]int{}
+	for scanner.Scan() {
+		levels, err := scanner.Line().Ints(" ")
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, levels)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
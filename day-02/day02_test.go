@@ -0,0 +1,23 @@
+package day02
+
+import (
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
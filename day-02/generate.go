@@ -0,0 +1,54 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 2.
+type Generator struct {
+	// Reports is the number of reports.
+	Reports int `param:"reports"`
+	// MaxLevels is the largest number of levels in a report.
+	MaxLevels int `param:"maxLevels"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Reports: 1000, MaxLevels: 8}
+}
+
+// Generate writes a random puzzle input. Most reports change steadily, with occasional bad levels, so that each
+// part has both safe and unsafe reports to find.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Reports < 1 {
+		return fmt.Errorf("reports must be at least 1, not %d", g.Reports)
+	}
+	if g.MaxLevels < 2 {
+		return fmt.Errorf("maxLevels must be at least 2, not %d", g.MaxLevels)
+	}
+
+	var b strings.Builder
+	for i := 0; i < g.Reports; i++ {
+		levelCount := 2 + rng.IntN(g.MaxLevels-1)
+		direction := 1
+		if rng.IntN(2) == 0 {
+			direction = -1
+		}
+		level := 10 + rng.IntN(80)
+		levels := []string{}
+		for j := 0; j < levelCount; j++ {
+			levels = append(levels, fmt.Sprint(level))
+			step := direction * (1 + rng.IntN(3))
+			if rng.IntN(8) == 0 {
+				step = rng.IntN(9) - 4
+			}
+			level = max(1, level+step)
+		}
+		b.WriteString(strings.Join(levels, " ") + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -1,30 +1,70 @@
-package main
+package day03
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
+	"context"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
 )
 
-func main() {
-	reports, err := readLines("input.txt")
+// Solver solves the puzzle for day 3.
+type Solver struct {
+	lines []string
+}
+
+// New creates a solver for the puzzle for day 3.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.lines = lines
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	total, err := partOne(ctx, s.lines)
 	if err != nil {
-		log.Fatal(err)
+		return solver.Answer{}, err
 	}
 
-	fmt.Println(partOne(reports))
-	fmt.Println(partTwo(reports))
+	return solver.IntAnswer(total), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	total, err := partTwo(ctx, s.lines)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(total), nil
 }
 
 // partOne solves part one of the puzzle.
-func partOne(lines []string) (int, error) {
+func partOne(ctx context.Context, lines []string) (int, error) {
 	regex := regexp.MustCompile(`mul\(\d{1,3},\d{1,3}\)`)
 	total := 0
 	for _, line := range lines {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		matches := regex.FindAllString(line, -1)
 		for _, match := range matches {
 			vals := strings.Split(strings.TrimLeft(strings.TrimRight(match, ")"), "mul("), ",")
@@ -45,10 +85,13 @@ func partOne(lines []string) (int, error) {
 }
 
 // partTwo solves part two of the puzzle.
-func partTwo(lines []string) (int, error) {
+func partTwo(ctx context.Context, lines []string) (int, error) {
 	regex := regexp.MustCompile(`mul\(\d{1,3},\d{1,3}\)|do\(\)|don't\(\)`)
 	total, do := 0, true
 	for _, line := range lines {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		matches := regex.FindAllString(line, -1)
 		for _, match := range matches {
 			if match == "do()" {
@@ -76,15 +119,9 @@ func partTwo(lines []string) (int, error) {
 	return total, nil
 }
 
-// readLines converts the information from the file into a usable form.
-func readLines(fileName string) ([]string, error) {
-	file, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]string, error) {
+	scanner := parse.NewScanner(r)
 
 	lines := []string{}
 	for scanner.Scan() {
@@ -0,0 +1,61 @@
+package day03
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 3.
+type Generator struct {
+	// Lines is the number of lines of corrupted memory.
+	Lines int `param:"lines"`
+	// Instructions is the number of instructions, valid or not, on each line.
+	Instructions int `param:"instructions"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Lines: 6, Instructions: 150}
+}
+
+// noise is corrupted text that appears between instructions.
+var noise = []string{"", "", "who()", "select()", "from()", "what()", "how()", "when()", "where()", "why()", "#", "!",
+	"@", "^", "&", "*", "[", "]", "{", "}", "<", ">", "'", "+", "-", "?", ",", ";", ":", " ", "%", "$", "~", "(", ")"}
+
+// Generate writes a random puzzle input, mixing valid mul, do and don't instructions with corrupted near misses.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Lines < 1 {
+		return fmt.Errorf("lines must be at least 1, not %d", g.Lines)
+	}
+	if g.Instructions < 0 {
+		return fmt.Errorf("instructions must not be negative, not %d", g.Instructions)
+	}
+
+	var b strings.Builder
+	for i := 0; i < g.Lines; i++ {
+		for j := 0; j < g.Instructions; j++ {
+			for k := rng.IntN(4); k > 0; k-- {
+				b.WriteString(noise[rng.IntN(len(noise))])
+			}
+			switch n := rng.IntN(20); {
+			case n == 0:
+				b.WriteString("do()")
+			case n == 1:
+				b.WriteString("don't()")
+			case n == 2:
+				fmt.Fprintf(&b, "mul(%d,%d", 1+rng.IntN(999), 1+rng.IntN(999))
+			case n == 3:
+				fmt.Fprintf(&b, "mul(%d %d)", 1+rng.IntN(999), 1+rng.IntN(999))
+			case n == 4:
+				fmt.Fprintf(&b, "mul(%d,%d)", 1000+rng.IntN(9000), 1+rng.IntN(999))
+			default:
+				fmt.Fprintf(&b, "mul(%d,%d)", 1+rng.IntN(999), 1+rng.IntN(999))
+			}
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
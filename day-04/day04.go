@@ -0,0 +1,119 @@
+package day04
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 4.
+type Solver struct {
+	wordSearch *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 4.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	wordSearch, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.wordSearch = wordSearch
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.wordSearch)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.wordSearch)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, wordSearch *grid.Grid[byte]) (int, error) {
+	total := 0
+	for _, start := range wordSearch.FindAll('X') {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for _, offset := range grid.Offsets8 {
+			if spells(wordSearch, start, offset, "XMAS") {
+				total++
+			}
+		}
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, wordSearch *grid.Grid[byte]) (int, error) {
+	diagonals := [2]grid.Point{{Row: 1, Col: 1}, {Row: 1, Col: -1}}
+	total := 0
+	for _, centre := range wordSearch.FindAll('A') {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		crossed := true
+		for _, diagonal := range diagonals {
+			// MAS may be written in either direction along each diagonal.
+			if !spells(wordSearch, centre.Sub(diagonal), diagonal, "MAS") &&
+				!spells(wordSearch, centre.Add(diagonal), diagonal.Scale(-1), "MAS") {
+				crossed = false
+			}
+		}
+		if crossed {
+			total++
+		}
+	}
+	return total, nil
+}
+
+// spells checks whether a word appears in the word search, starting at a position and stepping by an offset.
+func spells(wordSearch *grid.Grid[byte], start, offset grid.Point, word string) bool {
+	for i := 0; i < len(word); i++ {
+		if letter, ok := wordSearch.Get(start.Add(offset.Scale(i))); !ok || letter != word[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	wordSearch, err := parse.Grid(scanner, "XMAS")
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	return wordSearch, nil
+}
@@ -0,0 +1,34 @@
+package day04
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 4.
+type Generator struct {
+	// Size is the width and height of the word search.
+	Size int `param:"size"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 140}
+}
+
+// Generate writes a random puzzle input.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 1 {
+		return fmt.Errorf("size must be at least 1, not %d", g.Size)
+	}
+
+	wordSearch := grid.New[byte](g.Size, g.Size, 'X')
+	for position := range wordSearch.All() {
+		wordSearch.Set(position, "XMAS"[rng.IntN(4)])
+	}
+	_, err := wordSearch.WriteTo(w)
+	return err
+}
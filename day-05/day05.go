@@ -0,0 +1,212 @@
+package day05
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 5.
+type Solver struct {
+	rules   [][2]int
+	updates [][]int
+}
+
+// New creates a solver for the puzzle for day 5.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "2"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	rules, updates, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.rules, s.updates = rules, updates
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.rules, s.updates)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.rules, s.updates)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, rules [][2]int, updates [][]int) (int, error) {
+	ruleMap := map[[2]int]bool{}
+	for _, rule := range rules {
+		ruleMap[rule] = true
+	}
+	total := 0
+	for _, update := range updates {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if updateValid(ruleMap, update) {
+			total += update[(len(update)-1)/2]
+		}
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, rules [][2]int, updates [][]int) (int, error) {
+	ruleMap := map[[2]int]bool{}
+	for _, rule := range rules {
+		ruleMap[rule] = true
+	}
+	total := 0
+	for i, update := range updates {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if !updateValid(ruleMap, update) {
+			middle, err := middlePage(ruleMap, update)
+			if err != nil {
+				return 0, fmt.Errorf("update %d: %w", i+1, err)
+			}
+			total += middle
+		}
+	}
+	return total, nil
+}
+
+// updateValid checks whether an update satisfies all rules.
+func updateValid(ruleMap map[[2]int]bool, update []int) bool {
+	for i := 0; i < len(update)-1; i++ {
+		for j := i + 1; j < len(update); j++ {
+			if ruleMap[[2]int{update[j], update[i]}] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// errNoSingleMiddle is the error for an update that no order of its pages follows the rules for, or whose middle page
+// depends on which of the orders that follow the rules it is put in.
+var errNoSingleMiddle = errors.New("the rules do not give a single middle page")
+
+// middlePage finds the middle page of an update once it is in order. The rules need not order every pair of pages,
+// so the middle page is only the same in every order that follows them if the rules put it after each page before it
+// and before each page after it, directly or through other pages of the update.
+func middlePage(ruleMap map[[2]int]bool, update []int) (int, error) {
+	ordered, err := reorderUpdate(ruleMap, update)
+	if err != nil {
+		return 0, err
+	}
+	middle := ordered[(len(ordered)-1)/2]
+	if linkedPages(ruleMap, update, middle, false)+linkedPages(ruleMap, update, middle, true) != len(update)-1 {
+		return 0, errNoSingleMiddle
+	}
+	return middle, nil
+}
+
+// reorderUpdate puts the pages of an update in an order that follows the rules, by repeatedly taking a page that no
+// remaining page must come before. It returns an error if the rules order some of the pages in a cycle.
+func reorderUpdate(ruleMap map[[2]int]bool, update []int) ([]int, error) {
+	remaining := slices.Clone(update)
+	ordered := make([]int, 0, len(update))
+	for len(remaining) > 0 {
+		i := slices.IndexFunc(remaining, func(page int) bool {
+			return !slices.ContainsFunc(remaining, func(other int) bool {
+				return other != page && ruleMap[[2]int{other, page}]
+			})
+		})
+		if i == -1 {
+			return nil, errNoSingleMiddle
+		}
+		ordered = append(ordered, remaining[i])
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+	return ordered, nil
+}
+
+// linkedPages counts the other pages of an update that the rules put after a page, directly or through other pages of
+// the update, or before it if backwards is set.
+func linkedPages(ruleMap map[[2]int]bool, update []int, page int, backwards bool) int {
+	seen := map[int]bool{page: true}
+	stack := []int{page}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, other := range update {
+			rule := [2]int{current, other}
+			if backwards {
+				rule = [2]int{other, current}
+			}
+			if !seen[other] && ruleMap[rule] {
+				seen[other] = true
+				stack = append(stack, other)
+			}
+		}
+	}
+	return len(seen) - 1
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([][2]int, [][]int, error) {
+	scanner := parse.NewScanner(r)
+
+	rules, updates := [][2]int{}, [][]int{}
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			break
+		}
+		ruleStr, err := scanner.Line().SplitN("|", 2, `a rule such as "47|53"`)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		first, err := ruleStr[0].Int()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		second, err := ruleStr[1].Int()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rules = append(rules, [2]int{first, second})
+	}
+	for scanner.Scan() {
+		update, err := scanner.Line().Ints(",")
+		if err != nil {
+			return nil, nil, err
+		}
+		updates = append(updates, update)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return rules, updates, nil
+}
@@ -0,0 +1,68 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 5.
+type Generator struct {
+	// Pages is the number of different page numbers.
+	Pages int `param:"pages"`
+	// Updates is the number of updates.
+	Updates int `param:"updates"`
+	// RuleDensity is the percentage of the pairs of pages that have a rule. The real puzzle has a rule for every pair,
+	// while lower densities leave the pages in a partial order, in which some updates have no single middle page.
+	RuleDensity int `param:"ruleDensity"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Pages: 49, Updates: 200, RuleDensity: 100}
+}
+
+// Generate writes a random puzzle input. The rules put the pages in a random order, and about half of the updates
+// follow it.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Pages < 1 || g.Pages > 89 {
+		return fmt.Errorf("pages must be from 1 to 89, not %d", g.Pages)
+	}
+	if g.Updates < 0 {
+		return fmt.Errorf("updates must not be negative, not %d", g.Updates)
+	}
+	if g.RuleDensity < 0 || g.RuleDensity > 100 {
+		return fmt.Errorf("ruleDensity must be from 0 to 100, not %d", g.RuleDensity)
+	}
+
+	order := rng.Perm(89)[:g.Pages]
+	for i := range order {
+		order[i] += 11
+	}
+
+	var b strings.Builder
+	for _, i := range rng.Perm(g.Pages * g.Pages) {
+		first, second := i/g.Pages, i%g.Pages
+		if first < second && (g.RuleDensity == 100 || rng.IntN(100) < g.RuleDensity) {
+			fmt.Fprintf(&b, "%d|%d\n", order[first], order[second])
+		}
+	}
+	b.WriteString("\n")
+
+	for i := 0; i < g.Updates; i++ {
+		length := min(g.Pages, 1+2*rng.IntN(12))
+		positions := rng.Perm(g.Pages)[:length]
+		if rng.IntN(2) == 0 {
+			slices.Sort(positions)
+		}
+		update := []string{}
+		for _, position := range positions {
+			update = append(update, fmt.Sprint(order[position]))
+		}
+		b.WriteString(strings.Join(update, ",") + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
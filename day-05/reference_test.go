@@ -0,0 +1,89 @@
+package day05
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+// referenceFollowsRules checks every rule against an update, without assuming anything about the rules.
+func referenceFollowsRules(rules [][2]int, update []int) bool {
+	for _, rule := range rules {
+		first, second := slices.Index(update, rule[0]), slices.Index(update, rule[1])
+		if first != -1 && second != -1 && first > second {
+			return false
+		}
+	}
+	return true
+}
+
+// referenceMiddlePage finds the middle page of an out of order update once it is in order by trying every order of its
+// pages.
+// It returns an error unless the rules allow only one middle page.
+func referenceMiddlePage(rules [][2]int, update []int) (int, error) {
+	middles := map[int]bool{}
+	permute(slices.Clone(update), 0, func(order []int) {
+		if referenceFollowsRules(rules, order) {
+			middles[order[(len(order)-1)/2]] = true
+		}
+	})
+	if len(middles) != 1 {
+		return 0, errors.New("the rules do not give a single middle page")
+	}
+	for middle := range middles {
+		return middle, nil
+	}
+	return 0, nil
+}
+
+// permute calls visit with every order of the pages, keeping the first start of them in place.
+func permute(pages []int, start int, visit func([]int)) {
+	if start == len(pages) {
+		visit(pages)
+		return
+	}
+	for i := start; i < len(pages); i++ {
+		pages[start], pages[i] = pages[i], pages[start]
+		permute(pages, start+1, visit)
+		pages[start], pages[i] = pages[i], pages[start]
+	}
+}
+
+// referencePart solves a part of the puzzle by brute force, adding up the middle pages of the updates that are
+// already in order for part one or that are out of order for part two.
+func referencePart(rules [][2]int, updates [][]int, part int) (int, error) {
+	total := 0
+	for i, update := range updates {
+		if referenceFollowsRules(rules, update) != (part == 1) {
+			continue
+		}
+		if part == 1 {
+			total += update[(len(update)-1)/2]
+			continue
+		}
+		middle, err := referenceMiddlePage(rules, update)
+		if err != nil {
+			return 0, fmt.Errorf("update %d: %w", i+1, err)
+		}
+		total += middle
+	}
+	return total, nil
+}
+
+func TestMatchesReference(t *testing.T) {
+	for _, ruleDensity := range []int{100, 70} {
+		for part := 1; part <= 2; part++ {
+			solvertest.Differential(t, &Generator{Pages: 7, Updates: 8, RuleDensity: ruleDensity}, New,
+				func(s *Solver) (solver.Answer, error) { return solver.Part(context.Background(), s, part) },
+				func(s *Solver) (solver.Answer, error) {
+					total, err := referencePart(s.rules, s.updates, part)
+					return solver.IntAnswer(total), err
+				})
+		}
+	}
+}
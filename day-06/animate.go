@@ -0,0 +1,80 @@
+package day06
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// colours are the colours of the cells in the animations.
+var colours = map[byte]animate.Colour{
+	'#': animate.Grey,
+	'X': animate.Cyan,
+	'O': animate.Red,
+	'^': animate.Yellow,
+	'>': animate.Yellow,
+	'v': animate.Yellow,
+	'<': animate.Yellow,
+}
+
+// Animate shows the guard walking around the map for part one, and each obstruction that would trap the guard in a
+// loop for part two.
+func (s *Solver) Animate(part int) (iter.Seq[animate.Frame], error) {
+	switch part {
+	case 1:
+		return walkFrames(s.guardMap), nil
+	case 2:
+		return loopFrames(s.guardMap), nil
+	}
+	return nil, animate.ErrNoAnimation
+}
+
+// walkFrames yields a frame for each step of the guard, marking the positions they have visited, and stops once the
+// guard leaves the map or starts to walk in a loop.
+func walkFrames(guardMap *grid.Grid[byte]) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		picture := guardMap.Clone()
+		position, direction := findGuard(guardMap)
+		visitedPositions := map[grid.Point]bool{}
+		visitedStates := map[guardState]bool{}
+		for onMap := true; onMap; position, direction, onMap = step(guardMap, position, direction) {
+			if visitedStates[guardState{position, direction}] {
+				return
+			}
+			visitedStates[guardState{position, direction}] = true
+			visitedPositions[position] = true
+			picture.Set(position, byte(direction.Arrow()))
+			frame := animate.Frame{Grid: picture.Clone(), Colours: colours,
+				Caption: fmt.Sprintf("%d positions visited", len(visitedPositions))}
+			if !yield(frame) {
+				return
+			}
+			picture.Set(position, 'X')
+		}
+	}
+}
+
+// loopFrames yields a frame for each obstruction found that would trap the guard in a loop, marking all of those
+// found so far.
+func loopFrames(guardMap *grid.Grid[byte]) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		picture := guardMap.Clone()
+		startPosition, startDirection := findGuard(guardMap)
+		total := 0
+		for obstacle, cell := range guardMap.All() {
+			if obstacle == startPosition || cell == '#' || !loops(guardMap, obstacle, startPosition, startDirection) {
+				continue
+			}
+			total++
+			picture.Set(obstacle, 'O')
+			frame := animate.Frame{Grid: picture.Clone(), Colours: colours,
+				Highlights: map[grid.Point]animate.Colour{obstacle: animate.Magenta},
+				Caption:    fmt.Sprintf("%d obstructions found", total)}
+			if !yield(frame) {
+				return
+			}
+		}
+	}
+}
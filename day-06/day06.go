@@ -0,0 +1,163 @@
+package day06
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 6.
+type Solver struct {
+	guardMap *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 6.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "2"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	guardMap, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.guardMap = guardMap
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.guardMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.guardMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// guardState is where the guard is and which way they are facing. The guard walks in a loop once a state repeats.
+type guardState struct {
+	position  grid.Point
+	direction grid.Direction
+}
+
+// errGuardLoops is the error for a map on which the guard never leaves.
+var errGuardLoops = errors.New("the guard walks in a loop and never leaves the map")
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, guardMap *grid.Grid[byte]) (int, error) {
+	currentPosition, currentDirection := findGuard(guardMap)
+	visitedPositions := map[grid.Point]bool{}
+	visitedStates := map[guardState]bool{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		state := guardState{currentPosition, currentDirection}
+		if visitedStates[state] {
+			return 0, errGuardLoops
+		}
+		visitedStates[state] = true
+		visitedPositions[currentPosition] = true
+		var onMap bool
+		if currentPosition, currentDirection, onMap = step(guardMap, currentPosition, currentDirection); !onMap {
+			break
+		}
+	}
+	return len(visitedPositions), nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, guardMap *grid.Grid[byte]) (int, error) {
+	startPosition, startDirection := findGuard(guardMap)
+	total := 0
+	for obstacle, cell := range guardMap.All() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if obstacle == startPosition || cell == '#' {
+			continue
+		}
+		if loops(guardMap, obstacle, startPosition, startDirection) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+// step moves the guard one step forward, or turns them right if they are facing an obstruction. It reports whether
+// the guard is still on the map.
+func step(guardMap *grid.Grid[byte], position grid.Point, direction grid.Direction) (grid.Point, grid.Direction, bool) {
+	nextPosition := position.Move(direction)
+	if !guardMap.InBounds(nextPosition) {
+		return position, direction, false
+	} else if guardMap.At(nextPosition) == '#' {
+		return position, direction.Right(), true
+	}
+	return nextPosition, direction, true
+}
+
+// loops checks whether adding an obstruction to the map traps the guard in a loop.
+func loops(guardMap *grid.Grid[byte], obstacle, position grid.Point, direction grid.Direction) bool {
+	mapCopy := guardMap.Clone()
+	mapCopy.Set(obstacle, '#')
+	visitedPositions := map[grid.Point]int{}
+	for onMap := true; onMap; position, direction, onMap = step(mapCopy, position, direction) {
+		visitedPositions[position]++
+		if visitedPositions[position] >= 5 {
+			return true
+		}
+	}
+	return false
+}
+
+// findGuard finds the starting position and direction of the guard.
+func findGuard(guardMap *grid.Grid[byte]) (grid.Point, grid.Direction) {
+	position, _ := guardMap.FindFunc(isGuard)
+	direction, _ := grid.DirectionFromArrow(rune(guardMap.At(position)))
+	return position, direction
+}
+
+// isGuard checks whether a cell holds the guard.
+func isGuard(cell byte) bool {
+	_, ok := grid.DirectionFromArrow(rune(cell))
+	return ok
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	guardMap, err := parse.Grid(scanner, ".#^>v<")
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	if _, ok := guardMap.FindFunc(isGuard); !ok {
+		return nil, errors.New("map has no guard")
+	}
+	return guardMap, nil
+}
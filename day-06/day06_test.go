@@ -0,0 +1,50 @@
+package day06
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestGuardLoops(t *testing.T) {
+	for _, input := range []string{
+		".#..\n...#\n#^..\n..#.\n",
+		".#.\n#^#\n.#.\n",
+	} {
+		s := New()
+		if err := s.Parse(strings.NewReader(input)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.PartOne(context.Background()); !errors.Is(err, errGuardLoops) {
+			t.Errorf("%q: got error %v, want %v", input, err, errGuardLoops)
+		}
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	for part, want := range map[int]string{1: "41 positions visited", 2: "6 obstructions found"} {
+		frames := solvertest.Frames(t, New, "test_data.txt", nil, part)
+		if last := frames[len(frames)-1]; last.Caption != want {
+			t.Errorf("part %d: last frame has caption %q, want %q", part, last.Caption, want)
+		}
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,42 @@
+package day06
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 6.
+type Generator struct {
+	// Size is the width and height of the map.
+	Size int `param:"size"`
+	// ObstaclePercent is the percentage of positions that have an obstacle.
+	ObstaclePercent int `param:"obstaclePercent"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 130, ObstaclePercent: 2}
+}
+
+// Generate writes a random puzzle input, with the guard facing up from a random empty position.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 1 {
+		return fmt.Errorf("size must be at least 1, not %d", g.Size)
+	}
+	if g.ObstaclePercent < 0 || g.ObstaclePercent > 99 {
+		return fmt.Errorf("obstaclePercent must be from 0 to 99, not %d", g.ObstaclePercent)
+	}
+
+	guardMap := grid.New[byte](g.Size, g.Size, '.')
+	for position := range guardMap.All() {
+		if rng.IntN(100) < g.ObstaclePercent {
+			guardMap.Set(position, '#')
+		}
+	}
+	guardMap.Set(grid.Point{Row: rng.IntN(g.Size), Col: rng.IntN(g.Size)}, '^')
+	_, err := guardMap.WriteTo(w)
+	return err
+}
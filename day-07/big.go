@@ -0,0 +1,53 @@
+package day07
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+)
+
+// SetBigInt switches the solver to use big integers, so that the values of the equations can add up to more than
+// an int holds.
+func (s *Solver) SetBigInt(enabled bool) {
+	s.bigInt = enabled
+}
+
+// bigTotal adds up the values of the equations that can be made true with the allowed operators, working with big
+// integers throughout.
+func bigTotal(ctx context.Context, equations []Equation, allowed string) (*big.Int, error) {
+	total := new(big.Int)
+	for _, equation := range equations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		target := big.NewInt(int64(equation.Value))
+		if canSatisfyBig(big.NewInt(int64(equation.Numbers[0])), target, equation.Numbers[1:], allowed) {
+			total.Add(total, target)
+		}
+	}
+	return total, nil
+}
+
+// canSatisfyBig checks whether an equation can be satisfied by inserting operators from those allowed, '+', '*' and
+// '|' for concatenation.
+func canSatisfyBig(currentValue, targetValue *big.Int, remainingNumbers []int, allowed string) bool {
+	if len(remainingNumbers) == 0 {
+		return currentValue.Cmp(targetValue) == 0
+	}
+	number := big.NewInt(int64(remainingNumbers[0]))
+	for _, operator := range allowed {
+		value := new(big.Int)
+		switch operator {
+		case '+':
+			value.Add(currentValue, number)
+		case '*':
+			value.Mul(currentValue, number)
+		case '|':
+			value.SetString(currentValue.String()+strconv.Itoa(remainingNumbers[0]), 10)
+		}
+		if canSatisfyBig(value, targetValue, remainingNumbers[1:], allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package day07
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Operators is the witness for day 7: the operators that make each equation counted in the answer true.
+type Operators []SatisfiedEquation
+
+// SatisfiedEquation gives the operators, '+', '*' or '|' for concatenation, that go between the numbers of an
+// equation to make it true.
+type SatisfiedEquation struct {
+	Index     int // index of the equation in the input
+	Equation  Equation
+	Operators string
+}
+
+// String shows each equation with its operators filled in.
+func (o Operators) String() string {
+	lines := []string{}
+	for _, satisfied := range o {
+		var sb strings.Builder
+		sb.WriteString(strconv.Itoa(satisfied.Equation.Value) + ": " + strconv.Itoa(satisfied.Equation.Numbers[0]))
+		for i, number := range satisfied.Equation.Numbers[1:] {
+			operator := string(satisfied.Operators[i])
+			if operator == "|" {
+				operator = "||"
+			}
+			sb.WriteString(" " + operator + " " + strconv.Itoa(number))
+		}
+		lines = append(lines, sb.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Certify solves a part of the puzzle along with the operators for each equation that can be made true.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	allowed := "+*"
+	if part == 2 {
+		allowed = "+*|"
+	} else if part != 1 {
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	total := 0
+	witness := Operators{}
+	for i, equation := range s.equations {
+		if err := ctx.Err(); err != nil {
+			return solver.Answer{}, nil, err
+		}
+		operators, ok, err := findOperators(equation.Numbers[0], equation.Value, equation.Numbers[1:], allowed)
+		if err != nil {
+			return solver.Answer{}, nil, err
+		}
+		if ok {
+			if total, err = checked.Add(total, equation.Value); err != nil {
+				return solver.Answer{}, nil, err
+			}
+			witness = append(witness, SatisfiedEquation{Index: i, Equation: equation, Operators: operators})
+		}
+	}
+	return solver.IntAnswer(total), witness, nil
+}
+
+// findOperators finds operators from those allowed that, applied from left to right, take the current value to the
+// target value using the remaining numbers.
+func findOperators(currentValue, targetValue int, remainingNumbers []int, allowed string) (string, bool, error) {
+	if len(remainingNumbers) == 0 {
+		return "", currentValue == targetValue, nil
+	}
+	for _, operator := range allowed {
+		var value int
+		var err error
+		switch operator {
+		case '+':
+			value, err = checked.Add(currentValue, remainingNumbers[0])
+		case '*':
+			value, err = checked.Mul(currentValue, remainingNumbers[0])
+		case '|':
+			value, err = checked.Concat(currentValue, remainingNumbers[0])
+		}
+		if err != nil {
+			if err := overflowed(remainingNumbers[1:]); err != nil {
+				return "", false, err
+			}
+			continue
+		}
+		operators, ok, err := findOperators(value, targetValue, remainingNumbers[1:], allowed)
+		if ok || err != nil {
+			return string(operator) + operators, ok, err
+		}
+	}
+	return "", false, nil
+}
+
+// Verify checks that each equation in the witness is from the input and is made true by its operators, and that
+// their values add up to the answer. It cannot show that no other equation could be made true.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	operators, ok := witness.(Operators)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not day 7 operators", witness)
+	}
+	allowed := "+*"
+	if part == 2 {
+		allowed = "+*|"
+	} else if part != 1 {
+		return solver.ErrNoPart
+	}
+
+	total := new(big.Int)
+	seen := map[int]bool{}
+	for _, satisfied := range operators {
+		if satisfied.Index < 0 || satisfied.Index >= len(s.equations) || seen[satisfied.Index] {
+			return fmt.Errorf("equation %d is not in the input or is repeated", satisfied.Index)
+		}
+		seen[satisfied.Index] = true
+		equation := s.equations[satisfied.Index]
+		if equation.Value != satisfied.Equation.Value || !slices.Equal(equation.Numbers, satisfied.Equation.Numbers) {
+			return fmt.Errorf("equation %d does not match the input", satisfied.Index)
+		}
+		if len(satisfied.Operators) != len(equation.Numbers)-1 {
+			return fmt.Errorf("equation %d needs %d operators, not %d", satisfied.Index, len(equation.Numbers)-1,
+				len(satisfied.Operators))
+		}
+
+		// The operators are applied to big integers, so that no value can wrap around to look like the target.
+		value := big.NewInt(int64(equation.Numbers[0]))
+		for i, number := range equation.Numbers[1:] {
+			switch operator := satisfied.Operators[i]; {
+			case !strings.ContainsRune(allowed, rune(operator)):
+				return fmt.Errorf("equation %d uses %q, which part %d does not allow", satisfied.Index, operator, part)
+			case operator == '+':
+				value.Add(value, big.NewInt(int64(number)))
+			case operator == '*':
+				value.Mul(value, big.NewInt(int64(number)))
+			default:
+				if _, ok := value.SetString(value.String()+strconv.Itoa(number), 10); !ok {
+					return fmt.Errorf("equation %d cannot concatenate %d", satisfied.Index, number)
+				}
+			}
+		}
+		if value.Cmp(big.NewInt(int64(equation.Value))) != 0 {
+			return fmt.Errorf("equation %d comes to %s, not %d", satisfied.Index, value, equation.Value)
+		}
+		total.Add(total, value)
+	}
+
+	if answer.Big() == nil || answer.Big().Cmp(total) != 0 {
+		return fmt.Errorf("the equations add up to %s, not %s", total, answer)
+	}
+	return nil
+}
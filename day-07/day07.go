@@ -0,0 +1,214 @@
+package day07
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 7.
+type Solver struct {
+	equations []Equation
+	bigInt    bool
+}
+
+// New creates a solver for the puzzle for day 7.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	equations, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.equations = equations
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := bigTotal(ctx, s.equations, "+*")
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partOne(ctx, s.equations)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := bigTotal(ctx, s.equations, "+*|")
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partTwo(ctx, s.equations)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+type Equation struct {
+	Value   int
+	Numbers []int
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, equations []Equation) (int, error) {
+	total := 0
+	for _, equation := range equations {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		satisfied, err := canSatisfyEquationPartOne(equation.Numbers[0], equation.Value, equation.Numbers[1:])
+		if err != nil {
+			return 0, err
+		}
+		if satisfied {
+			if total, err = checked.Add(total, equation.Value); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, equations []Equation) (int, error) {
+	total := 0
+	for _, equation := range equations {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		satisfied, err := canSatisfyEquationPartTwo(equation.Numbers[0], equation.Value, equation.Numbers[1:])
+		if err != nil {
+			return 0, err
+		}
+		if satisfied {
+			if total, err = checked.Add(total, equation.Value); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return total, nil
+}
+
+// canSatisfyEquationPartOne checks whether an equation can be satisfied by inserting * or + operators.
+func canSatisfyEquationPartOne(currentValue, targetValue int, remainingNumbers []int) (bool, error) {
+	if len(remainingNumbers) == 0 {
+		return currentValue == targetValue, nil
+	}
+	val1, err1 := checked.Mul(currentValue, remainingNumbers[0])
+	val2, err2 := checked.Add(currentValue, remainingNumbers[0])
+	for _, next := range []struct {
+		value int
+		err   error
+	}{{val1, err1}, {val2, err2}} {
+		if next.err != nil {
+			if err := overflowed(remainingNumbers[1:]); err != nil {
+				return false, err
+			}
+			continue
+		}
+		satisfied, err := canSatisfyEquationPartOne(next.value, targetValue, remainingNumbers[1:])
+		if satisfied || err != nil {
+			return satisfied, err
+		}
+	}
+	return false, nil
+}
+
+// canSatisfyEquationPartTwo checks whether an equation can be satisfied by inserting *, + or || operators.
+func canSatisfyEquationPartTwo(currentValue, targetValue int, remainingNumbers []int) (bool, error) {
+	if len(remainingNumbers) == 0 {
+		return currentValue == targetValue, nil
+	}
+	val1, err1 := checked.Mul(currentValue, remainingNumbers[0])
+	val2, err2 := checked.Add(currentValue, remainingNumbers[0])
+	val3, err3 := checked.Concat(currentValue, remainingNumbers[0])
+	for _, next := range []struct {
+		value int
+		err   error
+	}{{val1, err1}, {val2, err2}, {val3, err3}} {
+		if next.err != nil {
+			if err := overflowed(remainingNumbers[1:]); err != nil {
+				return false, err
+			}
+			continue
+		}
+		satisfied, err := canSatisfyEquationPartTwo(next.value, targetValue, remainingNumbers[1:])
+		if satisfied || err != nil {
+			return satisfied, err
+		}
+	}
+	return false, nil
+}
+
+// overflowed decides what to do with a value that has grown too large for an int. None of the operators make a value
+// smaller when the remaining numbers are all positive, so such a value can never come back down to the target and
+// can be skipped. Otherwise the overflow is returned.
+func overflowed(remainingNumbers []int) error {
+	for _, number := range remainingNumbers {
+		if number < 1 {
+			return fmt.Errorf("%w in an equation with numbers below 1", checked.ErrOverflow)
+		}
+	}
+	return nil
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]Equation, error) {
+	scanner := parse.NewScanner(r)
+
+	equations := []Equation{}
+	for scanner.Scan() {
+		valueStr, numbersStr, err := scanner.Line().Cut(": ", `an equation such as "190: 10 19"`)
+		if err != nil {
+			return nil, err
+		}
+
+		equation := Equation{}
+		value, err := valueStr.Int()
+		if err != nil {
+			return nil, err
+		}
+
+		equation.Value = value
+		numbers, err := numbersStr.Ints(" ")
+		if err != nil {
+			return nil, err
+		}
+		equation.Numbers = numbers
+		equations = append(equations, equation)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return equations, nil
+}
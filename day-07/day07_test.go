@@ -0,0 +1,77 @@
+package day07
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGoldenBigInt(t *testing.T) {
+	solvertest.GoldenBigInt(t, New)
+}
+
+func TestOverflow(t *testing.T) {
+	// Each value fits in an int, but their total does not, and multiplying the second equation's numbers overflows.
+	input := "9000000000000000000: 9000000000000000000\n9000000000000000000: 3 3000000000000000000 0\n"
+	s := New()
+	if err := s.Parse(strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+	for part := 1; part <= 2; part++ {
+		if _, err := solver.Part(context.Background(), s, part); !errors.Is(err, checked.ErrOverflow) {
+			t.Errorf("part %d: got error %v, want %v", part, err, checked.ErrOverflow)
+		}
+	}
+
+	s.SetBigInt(true)
+	for part := 1; part <= 2; part++ {
+		answer, err := solver.Part(context.Background(), s, part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if answer.Kind() != solver.Big || answer.String() != "18000000000000000000" {
+			t.Errorf("part %d: got %s answer %s, want big answer 18000000000000000000", part, answer.Kind(), answer)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestCertify(t *testing.T) {
+	for part := 1; part <= 2; part++ {
+		answer, witness := solvertest.Certified(t, New, "test_data.txt", nil, part)
+
+		s := New()
+		if err := solvertest.Parse(s, "test_data.txt", nil); err != nil {
+			t.Fatal(err)
+		}
+		operators := slices.Clone(witness.(Operators))
+		operators[0].Operators = strings.Repeat("+", len(operators[0].Operators))
+		if err := s.Verify(part, answer, operators); err == nil {
+			t.Errorf("part %d: verified a witness with the wrong operators", part)
+		}
+		if err := s.Verify(part, answer, witness.(Operators)[1:]); err == nil {
+			t.Errorf("part %d: verified a witness missing an equation", part)
+		}
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,84 @@
+package day07
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 7.
+type Generator struct {
+	// Equations is the number of equations.
+	Equations int `param:"equations"`
+	// MaxNumbers is the largest number of numbers in an equation.
+	MaxNumbers int `param:"maxNumbers"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Equations: 850, MaxNumbers: 12}
+}
+
+// maxValue keeps test values well within the range of an int.
+const maxValue = 1_000_000_000_000_000
+
+// Generate writes a random puzzle input. Most test values come from combining the numbers with random operators,
+// so that both parts have true equations to find, and the rest are random.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Equations < 0 {
+		return fmt.Errorf("equations must not be negative, not %d", g.Equations)
+	}
+	if g.MaxNumbers < 2 {
+		return fmt.Errorf("maxNumbers must be at least 2, not %d", g.MaxNumbers)
+	}
+
+	var b strings.Builder
+	for i := 0; i < g.Equations; {
+		numbers := []int{}
+		for j := 2 + rng.IntN(g.MaxNumbers-1); j > 0; j-- {
+			numbers = append(numbers, 1+rng.IntN(rng.IntN(999)+1))
+		}
+		value, ok := combine(numbers, rng)
+		if !ok {
+			continue
+		}
+		if rng.IntN(3) == 0 {
+			value = 1 + rng.IntN(value)
+		}
+
+		numberStrs := []string{}
+		for _, number := range numbers {
+			numberStrs = append(numberStrs, strconv.Itoa(number))
+		}
+		fmt.Fprintf(&b, "%d: %s\n", value, strings.Join(numberStrs, " "))
+		i++
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// combine combines the numbers from left to right with random operators, reporting false if the value grows too
+// large.
+func combine(numbers []int, rng *rand.Rand) (int, bool) {
+	value := numbers[0]
+	for _, number := range numbers[1:] {
+		switch rng.IntN(3) {
+		case 0:
+			value += number
+		case 1:
+			value *= number
+		case 2:
+			concatenated, err := strconv.Atoi(strconv.Itoa(value) + strconv.Itoa(number))
+			if err != nil {
+				return 0, false
+			}
+			value = concatenated
+		}
+		if value > maxValue {
+			return 0, false
+		}
+	}
+	return value, true
+}
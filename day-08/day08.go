@@ -0,0 +1,127 @@
+package day08
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 8.
+type Solver struct {
+	antennaMap *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 8.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	antennaMap, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.antennaMap = antennaMap
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.antennaMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.antennaMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, antennaMap *grid.Grid[byte]) (int, error) {
+	antinodes := map[grid.Point]bool{}
+	for _, locations := range antennaLocations(antennaMap) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for i := 0; i < len(locations)-1; i++ {
+			for j := i + 1; j < len(locations); j++ {
+				diff := locations[i].Sub(locations[j])
+				first := locations[i].Add(diff)
+				second := locations[j].Sub(diff)
+				if antennaMap.InBounds(first) {
+					antinodes[first] = true
+				}
+				if antennaMap.InBounds(second) {
+					antinodes[second] = true
+				}
+			}
+		}
+	}
+	return len(antinodes), nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, antennaMap *grid.Grid[byte]) (int, error) {
+	antinodes := map[grid.Point]bool{}
+	for _, locations := range antennaLocations(antennaMap) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for i := 0; i < len(locations)-1; i++ {
+			for j := i + 1; j < len(locations); j++ {
+				diff := locations[i].Sub(locations[j])
+				for first := locations[i]; antennaMap.InBounds(first); first = first.Add(diff) {
+					antinodes[first] = true
+				}
+				for second := locations[j]; antennaMap.InBounds(second); second = second.Sub(diff) {
+					antinodes[second] = true
+				}
+			}
+		}
+	}
+	return len(antinodes), nil
+}
+
+// antennaLocations groups the locations of the antennas by frequency.
+func antennaLocations(antennaMap *grid.Grid[byte]) map[byte][]grid.Point {
+	antennaLocations := map[byte][]grid.Point{}
+	for position, antenna := range antennaMap.All() {
+		if antenna != '.' {
+			antennaLocations[antenna] = append(antennaLocations[antenna], position)
+		}
+	}
+	return antennaLocations
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	antennaMap, err := parse.Grid(scanner, ".#0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	return antennaMap, nil
+}
@@ -0,0 +1,49 @@
+package day08
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// frequencies are the characters that mark an antenna.
+const frequencies = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// Generator generates random puzzle inputs for day 8.
+type Generator struct {
+	// Size is the width and height of the map.
+	Size int `param:"size"`
+	// Frequencies is the number of different antenna frequencies.
+	Frequencies int `param:"frequencies"`
+	// Antennas is the number of antennas of each frequency.
+	Antennas int `param:"antennas"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 50, Frequencies: 46, Antennas: 4}
+}
+
+// Generate writes a random puzzle input.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 1 {
+		return fmt.Errorf("size must be at least 1, not %d", g.Size)
+	}
+	if g.Frequencies < 0 || g.Frequencies > len(frequencies) {
+		return fmt.Errorf("frequencies must be from 0 to %d, not %d", len(frequencies), g.Frequencies)
+	}
+	if g.Antennas < 0 || g.Frequencies*g.Antennas > g.Size*g.Size {
+		return fmt.Errorf("%d antennas of %d frequencies do not fit on the map", g.Antennas, g.Frequencies)
+	}
+
+	antennaMap := grid.New[byte](g.Size, g.Size, '.')
+	positions := rng.Perm(g.Size * g.Size)
+	for i := 0; i < g.Frequencies*g.Antennas; i++ {
+		position := grid.Point{Row: positions[i] / g.Size, Col: positions[i] % g.Size}
+		antennaMap.Set(position, frequencies[i/g.Antennas])
+	}
+	_, err := antennaMap.WriteTo(w)
+	return err
+}
@@ -1,27 +1,62 @@
-package main
+package day09
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
+	"context"
+	"io"
 	"slices"
-	"strconv"
-	"strings"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
 )
 
-func main() {
-	diskMap, err := readLines("input.txt")
+// Solver solves the puzzle for day 9.
+type Solver struct {
+	diskMap []int
+}
+
+// New creates a solver for the puzzle for day 9.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	diskMap, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.diskMap = diskMap
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.diskMap)
 	if err != nil {
-		log.Fatal(err)
+		return solver.Answer{}, err
 	}
 
-	fmt.Println(partOne(diskMap))
-	fmt.Println(partTwo(diskMap))
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.diskMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
 }
 
 // partOne solves part one of the puzzle.
-func partOne(diskMap []int) int {
+func partOne(ctx context.Context, diskMap []int) (int, error) {
 	blocks := []int{}
 	for i, value := range diskMap {
 		blocks = append(blocks, getBlock(i, value)...)
@@ -35,6 +70,9 @@ func partOne(diskMap []int) int {
 	}
 	lastTaken := len(blocks) - 1
 	for firstFree < lastTaken {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		blocks[firstFree] = blocks[lastTaken]
 		blocks[lastTaken] = -1
 		for i := firstFree + 1; i < len(blocks); i++ {
@@ -50,7 +88,7 @@ func partOne(diskMap []int) int {
 			}
 		}
 	}
-	return calculateChecksum(blocks)
+	return calculateChecksum(blocks), nil
 }
 
 type Block struct {
@@ -60,7 +98,7 @@ type Block struct {
 }
 
 // partTwo solves part two of the puzzle.
-func partTwo(diskMap []int) int {
+func partTwo(ctx context.Context, diskMap []int) (int, error) {
 	blocks := []int{}
 	for i, value := range diskMap {
 		blocks = append(blocks, getBlock(i, value)...)
@@ -78,6 +116,9 @@ func partTwo(diskMap []int) int {
 	}
 	slices.Reverse(fileBlocks)
 	for _, block := range fileBlocks {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		minIndex := block.Index
 		gapSize := -1
 		for i := block.Length; i <= 9; i++ {
@@ -97,7 +138,7 @@ func partTwo(diskMap []int) int {
 
 		}
 	}
-	return calculateChecksum(blocks)
+	return calculateChecksum(blocks), nil
 }
 
 // getBlock returns a block based on the given index and value.
@@ -128,26 +169,17 @@ func calculateChecksum(blocks []int) int {
 	return total
 }
 
-// readLines converts the information from the file into a usable form.
-func readLines(fileName string) ([]int, error) {
-	file, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]int, error) {
+	scanner := parse.NewScanner(r)
 
 	diskMap := []int{}
 	for scanner.Scan() {
-		values := strings.Split(scanner.Text(), "")
-		for _, valueStr := range values {
-			value, err := strconv.Atoi(valueStr)
-			if err != nil {
-				return nil, err
-			}
-			diskMap = append(diskMap, value)
+		values, err := scanner.Line().Digits()
+		if err != nil {
+			return nil, err
 		}
+		diskMap = append(diskMap, values...)
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, err
@@ -0,0 +1,38 @@
+package day09
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 9.
+type Generator struct {
+	// Files is the number of files on the disk.
+	Files int `param:"files"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Files: 10000}
+}
+
+// Generate writes a random puzzle input: a disk map alternating between files of 1 to 9 blocks and free space of 0
+// to 9 blocks.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Files < 1 {
+		return fmt.Errorf("files must be at least 1, not %d", g.Files)
+	}
+
+	var b strings.Builder
+	for i := 0; i < g.Files; i++ {
+		if i > 0 {
+			b.WriteByte(byte('0' + rng.IntN(10)))
+		}
+		b.WriteByte(byte('1' + rng.IntN(9)))
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,132 @@
+package day10
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 10.
+type Solver struct {
+	trailMap *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 10.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	trailMap, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.trailMap = trailMap
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.trailMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.trailMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, trailMap *grid.Grid[byte]) (int, error) {
+	total := 0
+	for _, trailHead := range trailMap.FindAll('0') {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		total += score(trailHead, trailMap, false)
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, trailMap *grid.Grid[byte]) (int, error) {
+	total := 0
+	for _, trailHead := range trailMap.FindAll('0') {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		total += score(trailHead, trailMap, true)
+	}
+	return total, nil
+}
+
+// score determines the score for a trailhead.
+func score(trailheadPosition grid.Point, trailMap *grid.Grid[byte], countAllTrails bool) int {
+	trails := [][]grid.Point{{trailheadPosition}}
+	for i := 1; i <= 9; i++ {
+		newTrails := [][]grid.Point{}
+		for _, trail := range trails {
+			validNeighbours := validNeighbours(trail[len(trail)-1], trailMap)
+			for _, validNeighbour := range validNeighbours {
+				extendedTrail := make([]grid.Point, len(trail)+1)
+				copy(extendedTrail, trail)
+				extendedTrail[len(extendedTrail)-1] = validNeighbour
+				newTrails = append(newTrails, extendedTrail)
+			}
+		}
+		trails = newTrails
+	}
+	if countAllTrails {
+		return len(trails)
+	} else {
+		endPoints := map[grid.Point]bool{}
+		for _, trail := range trails {
+			endPoints[trail[len(trail)-1]] = true
+		}
+		return len(endPoints)
+	}
+}
+
+// validNeighbours finds all valid neighbouring positions.
+func validNeighbours(position grid.Point, trailMap *grid.Grid[byte]) []grid.Point {
+	validNeighours := []grid.Point{}
+	for neighbour := range trailMap.Neighbours4(position) {
+		if trailMap.At(neighbour) == trailMap.At(position)+1 {
+			validNeighours = append(validNeighours, neighbour)
+		}
+	}
+	return validNeighours
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	trailMap, err := parse.Grid(scanner, "0123456789")
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	return trailMap, nil
+}
@@ -0,0 +1,51 @@
+package day10
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 10.
+type Generator struct {
+	// Size is the width and height of the map.
+	Size int `param:"size"`
+	// Trails is the number of hiking trails laid over the random heights.
+	Trails int `param:"trails"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 51, Trails: 250}
+}
+
+// Generate writes a random puzzle input. Random heights alone would give almost no trails, so the map also has
+// trails from 0 to 9 laid over it by random walks.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 1 {
+		return fmt.Errorf("size must be at least 1, not %d", g.Size)
+	}
+	if g.Trails < 0 {
+		return fmt.Errorf("trails must not be negative, not %d", g.Trails)
+	}
+
+	trailMap := grid.New[byte](g.Size, g.Size, '0')
+	for position := range trailMap.All() {
+		trailMap.Set(position, byte('0'+rng.IntN(10)))
+	}
+	for i := 0; i < g.Trails; i++ {
+		position := grid.Point{Row: rng.IntN(g.Size), Col: rng.IntN(g.Size)}
+		for height := byte('0'); height <= '9'; height++ {
+			trailMap.Set(position, height)
+			next := position.Move(grid.Directions[rng.IntN(len(grid.Directions))])
+			if !trailMap.InBounds(next) {
+				break
+			}
+			position = next
+		}
+	}
+	_, err := trailMap.WriteTo(w)
+	return err
+}
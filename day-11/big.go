@@ -0,0 +1,57 @@
+package day11
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// SetBigInt switches the solver to use big integers, so that the numbers on the stones and the count of stones can
+// grow beyond an int.
+func (s *Solver) SetBigInt(enabled bool) {
+	s.bigInt = enabled
+}
+
+// iterateBigStones performs iterations on the stones and returns the final stone count. The numbers on the stones
+// are kept as decimal strings, which makes splitting them in two a matter of cutting the digits in half.
+func iterateBigStones(ctx context.Context, stones []int, iterations int) (*big.Int, error) {
+	stonesMap := map[string]*big.Int{}
+	add := func(stones map[string]*big.Int, stone string, count *big.Int) {
+		if stones[stone] == nil {
+			stones[stone] = new(big.Int)
+		}
+		stones[stone].Add(stones[stone], count)
+	}
+	for _, stone := range stones {
+		add(stonesMap, strconv.Itoa(stone), big.NewInt(1))
+	}
+	multiplier := big.NewInt(2024)
+	for i := 1; i <= iterations; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		newStonesMap := map[string]*big.Int{}
+		for stone, count := range stonesMap {
+			if stone == "0" {
+				add(newStonesMap, "1", count)
+			} else if len(stone)%2 == 0 {
+				second := strings.TrimLeft(stone[len(stone)/2:], "0")
+				if second == "" {
+					second = "0"
+				}
+				add(newStonesMap, stone[:len(stone)/2], count)
+				add(newStonesMap, second, count)
+			} else {
+				number, _ := new(big.Int).SetString(stone, 10)
+				add(newStonesMap, number.Mul(number, multiplier).String(), count)
+			}
+		}
+		stonesMap = newStonesMap
+	}
+	total := new(big.Int)
+	for _, count := range stonesMap {
+		total.Add(total, count)
+	}
+	return total, nil
+}
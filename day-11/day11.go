@@ -0,0 +1,185 @@
+package day11
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 11.
+type Solver struct {
+	// PartOneBlinks and PartTwoBlinks are the number of times the stones blink in each part.
+	PartOneBlinks int `param:"partOneBlinks"`
+	PartTwoBlinks int `param:"partTwoBlinks"`
+
+	stones []int
+	bigInt bool
+}
+
+// New creates a solver for the puzzle for day 11.
+func New() *Solver {
+	return &Solver{PartOneBlinks: 25, PartTwoBlinks: 75}
+}
+
+// maxBlinks is the largest number of blinks, beyond which even big integers take too long to count the stones.
+const maxBlinks = 1000
+
+// Validate checks that the stones blink from 1 to maxBlinks times in each part.
+func (s *Solver) Validate() error {
+	if err := solver.CheckRange("partOneBlinks", s.PartOneBlinks, 1, maxBlinks); err != nil {
+		return err
+	}
+	return solver.CheckRange("partTwoBlinks", s.PartTwoBlinks, 1, maxBlinks)
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	stones, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.stones = stones
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := iterateBigStones(ctx, s.stones, s.PartOneBlinks)
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partOne(ctx, s.stones, s.PartOneBlinks)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := iterateBigStones(ctx, s.stones, s.PartTwoBlinks)
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partTwo(ctx, s.stones, s.PartTwoBlinks)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, stones []int, blinks int) (int, error) {
+	return iterateStones(ctx, stones, blinks)
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, stones []int, blinks int) (int, error) {
+	return iterateStones(ctx, stones, blinks)
+}
+
+// iterateStones performs iterations on the stones and returns the final stone count
+func iterateStones(ctx context.Context, stones []int, iterations int) (int, error) {
+	stonesMap := map[int]int{}
+	for _, stone := range stones {
+		stonesMap[stone]++
+	}
+	for i := 1; i <= iterations; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		newStonesMap := map[int]int{}
+		add := func(stone, count int) (err error) {
+			newStonesMap[stone], err = checked.Add(newStonesMap[stone], count)
+			return err
+		}
+		for stone, count := range stonesMap {
+			var err error
+			if stone == 0 {
+				err = add(1, count)
+			} else if digitCount := countDigits(stone); digitCount%2 == 0 {
+				stone1, stone2 := splitNumber(stone)
+				if err = add(stone1, count); err == nil {
+					err = add(stone2, count)
+				}
+			} else {
+				var newStone int
+				if newStone, err = checked.Mul(stone, 2024); err == nil {
+					err = add(newStone, count)
+				}
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+		stonesMap = newStonesMap
+	}
+	total := 0
+	for _, count := range stonesMap {
+		var err error
+		if total, err = checked.Add(total, count); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// countDigits counts the number of digits that a number has.
+func countDigits(number int) int {
+	digits := 1
+	for {
+		number = number / 10
+		if number == 0 {
+			return digits
+		}
+		digits++
+	}
+}
+
+// splitNumber splits a number in two.
+func splitNumber(number int) (int, int) {
+	digitCount := countDigits(number)
+	powerOfTen := 10
+	for i := 1; i < digitCount/2; i++ {
+		powerOfTen *= 10
+	}
+	return number / powerOfTen, number % powerOfTen
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]int, error) {
+	scanner := parse.NewScanner(r)
+
+	stones := []int{}
+	for scanner.Scan() {
+		lineStones, err := scanner.Line().Ints(" ")
+		if err != nil {
+			return nil, err
+		}
+		stones = append(stones, lineStones...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return stones, nil
+}
@@ -0,0 +1,52 @@
+package day11
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGoldenBigInt(t *testing.T) {
+	solvertest.GoldenBigInt(t, New)
+}
+
+func TestOverflow(t *testing.T) {
+	// After 300 blinks there are around 10^36 stones.
+	params := map[string]int{"partTwoBlinks": 300}
+	s := New()
+	if err := solvertest.Parse(s, "test_data.txt", params); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PartTwo(context.Background()); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("got error %v, want %v", err, checked.ErrOverflow)
+	}
+
+	s.SetBigInt(true)
+	answer, err := s.PartTwo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if answer.Kind() != solver.Big || len(answer.String()) < 30 {
+		t.Errorf("got %s answer %s, want a big answer", answer.Kind(), answer)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,37 @@
+package day11
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 11.
+type Generator struct {
+	// Stones is the number of stones.
+	Stones int `param:"stones"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Stones: 8}
+}
+
+// Generate writes a random puzzle input, with numbers of between 1 and 7 digits engraved on the stones.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Stones < 1 {
+		return fmt.Errorf("stones must be at least 1, not %d", g.Stones)
+	}
+
+	stones := []string{}
+	for i := 0; i < g.Stones; i++ {
+		limit := 1
+		for digits := 1 + rng.IntN(7); digits > 0; digits-- {
+			limit *= 10
+		}
+		stones = append(stones, fmt.Sprint(rng.IntN(limit)))
+	}
+	_, err := io.WriteString(w, strings.Join(stones, " ")+"\n")
+	return err
+}
@@ -0,0 +1,188 @@
+package day12
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 12.
+type Solver struct {
+	gardenMap *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 12.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	gardenMap, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.gardenMap = gardenMap
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.gardenMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.gardenMap)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, gardenMap *grid.Grid[byte]) (int, error) {
+	consideredPlots := map[grid.Point]bool{}
+	total := 0
+	for plot := range gardenMap.All() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if consideredPlots[plot] {
+			continue
+		}
+		region := constructRegion(plot, gardenMap)
+		for plot := range region {
+			consideredPlots[plot] = true
+		}
+		total += perimeter(region) * len(region)
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, gardenMap *grid.Grid[byte]) (int, error) {
+	consideredPlots := map[grid.Point]bool{}
+	total := 0
+	for plot := range gardenMap.All() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if consideredPlots[plot] {
+			continue
+		}
+		region := constructRegion(plot, gardenMap)
+		for plot := range region {
+			consideredPlots[plot] = true
+		}
+		total += sides(region) * len(region)
+	}
+	return total, nil
+}
+
+// constructRegion creates a region of all connected plots of the same type.
+func constructRegion(plot grid.Point, gardenMap *grid.Grid[byte]) map[grid.Point]bool {
+	region := map[grid.Point]bool{plot: true}
+	plotsToConsider := map[grid.Point]bool{plot: true}
+	for len(plotsToConsider) > 0 {
+		newPlots := map[grid.Point]bool{}
+		for plot := range plotsToConsider {
+			for adjacentPlot := range gardenMap.Neighbours4(plot) {
+				if gardenMap.At(adjacentPlot) == gardenMap.At(plot) {
+					if !region[adjacentPlot] {
+						newPlots[adjacentPlot] = true
+					}
+					region[adjacentPlot] = true
+				}
+			}
+		}
+		plotsToConsider = newPlots
+	}
+	return region
+}
+
+// perimeter calculates the perimeter of a region.
+func perimeter(region map[grid.Point]bool) int {
+	perimeter := 0
+	for plot := range region {
+		for adjacentPosition := range plot.Neighbours4() {
+			if !region[adjacentPosition] {
+				perimeter++
+			}
+		}
+	}
+	return perimeter
+}
+
+type Fence struct {
+	Plot      grid.Point
+	Direction grid.Direction // the side of the plot that the fence is on
+}
+
+// sides counts the sides of a region.
+func sides(region map[grid.Point]bool) int {
+	fences := map[Fence]bool{}
+	for plot := range region {
+		for _, direction := range grid.Directions {
+			if !region[plot.Move(direction)] {
+				fences[Fence{Plot: plot, Direction: direction}] = true
+			}
+		}
+	}
+	sides := 0
+	consideredFences := map[Fence]bool{}
+	for fence := range fences {
+		if consideredFences[fence] {
+			continue
+		}
+		sides++
+		consideredFences[fence] = true
+		fencesToConsider := map[Fence]bool{fence: true}
+		for len(fencesToConsider) > 0 {
+			newFences := map[Fence]bool{}
+			for fenceToConsider := range fencesToConsider {
+				// Fences on the same side continue along the directions perpendicular to the one they face.
+				for _, along := range []grid.Direction{fenceToConsider.Direction.Left(), fenceToConsider.Direction.Right()} {
+					adjacent := Fence{Plot: fenceToConsider.Plot.Move(along), Direction: fenceToConsider.Direction}
+					if fences[adjacent] {
+						if !consideredFences[adjacent] {
+							newFences[adjacent] = true
+						}
+						consideredFences[adjacent] = true
+					}
+				}
+			}
+			fencesToConsider = newFences
+		}
+	}
+	return sides
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	gardenMap, err := parse.Grid(scanner, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	return gardenMap, nil
+}
@@ -0,0 +1,54 @@
+package day12
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 12.
+type Generator struct {
+	// Size is the width and height of the garden.
+	Size int `param:"size"`
+	// Regions is the number of points that regions grow from. Neighbouring regions may share a plant type, and so
+	// merge.
+	Regions int `param:"regions"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 140, Regions: 600}
+}
+
+// Generate writes a random puzzle input, in which each plot takes the plant type of the nearest of a set of random
+// points.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 1 {
+		return fmt.Errorf("size must be at least 1, not %d", g.Size)
+	}
+	if g.Regions < 1 {
+		return fmt.Errorf("regions must be at least 1, not %d", g.Regions)
+	}
+
+	seeds := []grid.Point{}
+	plants := []byte{}
+	for i := 0; i < g.Regions; i++ {
+		seeds = append(seeds, grid.Point{Row: rng.IntN(g.Size), Col: rng.IntN(g.Size)})
+		plants = append(plants, byte('A'+rng.IntN(26)))
+	}
+
+	gardenMap := grid.New[byte](g.Size, g.Size, 'A')
+	for position := range gardenMap.All() {
+		nearest := 0
+		for i, seed := range seeds {
+			if position.Manhattan(seed) < position.Manhattan(seeds[nearest]) {
+				nearest = i
+			}
+		}
+		gardenMap.Set(position, plants[nearest])
+	}
+	_, err := gardenMap.WriteTo(w)
+	return err
+}
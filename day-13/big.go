@@ -0,0 +1,99 @@
+package day13
+
+import (
+	"context"
+	"math/big"
+)
+
+// SetBigInt switches the solver to use big integers in part two, so that the prizes can be moved further than an
+// int can reach.
+func (s *Solver) SetBigInt(enabled bool) {
+	s.bigInt = enabled
+}
+
+// bigPartTwo solves part two of the puzzle with big integers.
+func bigPartTwo(ctx context.Context, machines []Machine, prizeOffset int) (*big.Int, error) {
+	total := new(big.Int)
+	offset := big.NewInt(int64(prizeOffset))
+	for _, machine := range machines {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var buttonA, buttonB, prize [2]*big.Int
+		for axis := range 2 {
+			buttonA[axis] = big.NewInt(int64(machine.ButtonAMovements[axis]))
+			buttonB[axis] = big.NewInt(int64(machine.ButtonBMovements[axis]))
+			prize[axis] = new(big.Int).Add(big.NewInt(int64(machine.PrizePosition[axis])), offset)
+		}
+
+		var a, b *big.Int
+		determinant := bigCrossDifference(buttonA[0], buttonB[1], buttonA[1], buttonB[0])
+		if determinant.Sign() == 0 {
+			var ok bool
+			var err error
+			if a, b, ok, err = bigCollinearPresses(ctx, machine, prize); err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+		} else {
+			var aRemainder, bRemainder *big.Int
+			a, aRemainder = new(big.Int).QuoRem(bigCrossDifference(prize[0], buttonB[1], prize[1], buttonB[0]),
+				determinant, new(big.Int))
+			b, bRemainder = new(big.Int).QuoRem(bigCrossDifference(buttonA[0], prize[1], buttonA[1], prize[0]),
+				determinant, new(big.Int))
+			if aRemainder.Sign() != 0 || bRemainder.Sign() != 0 || a.Sign() < 0 || b.Sign() < 0 {
+				continue
+			}
+		}
+		total.Add(total, a.Mul(a, big.NewInt(3)))
+		total.Add(total, b)
+	}
+	return total, nil
+}
+
+// bigCollinearPresses finds the cheapest presses for a machine whose buttons move the claw along the same line, with
+// the prize at the given position, as collinearPresses does.
+func bigCollinearPresses(ctx context.Context, machine Machine, prize [2]*big.Int) (*big.Int, *big.Int, bool, error) {
+	buttonA, buttonB := machine.ButtonAMovements, machine.ButtonBMovements
+	for _, button := range [][2]int{buttonA, buttonB} {
+		cross := bigCrossDifference(prize[0], big.NewInt(int64(button[1])), prize[1], big.NewInt(int64(button[0])))
+		if cross.Sign() != 0 {
+			return nil, nil, false, nil
+		}
+	}
+	axis := lineAxis(machine)
+	if buttonA[axis] == 0 && buttonB[axis] == 0 {
+		return new(big.Int), new(big.Int), prize[0].Sign() == 0 && prize[1].Sign() == 0, nil
+	}
+
+	// fewer is the button pressed as few times as possible and more the other, moving the claw fewerStep and moreStep.
+	fewer, more := 1, 0
+	if buttonA[axis] == 0 || (buttonB[axis] != 0 && !aIsCheaper(buttonA[axis], buttonB[axis])) {
+		fewer, more = 0, 1
+	}
+	steps := [2]int{buttonA[axis], buttonB[axis]}
+	fewerStep, moreStep := big.NewInt(int64(steps[fewer])), big.NewInt(int64(steps[more]))
+	var pressed [2]*big.Int
+	pressed[fewer] = new(big.Int)
+	if steps[fewer] != 0 {
+		remainder := new(big.Int).Mod(prize[axis], moreStep).Int64()
+		k, ok, err := fewestPresses(ctx, steps[fewer], int(remainder), steps[more])
+		if err != nil || !ok {
+			return nil, nil, false, err
+		}
+		pressed[fewer].SetInt64(int64(k))
+	}
+	rest := new(big.Int).Sub(prize[axis], new(big.Int).Mul(pressed[fewer], fewerStep))
+	pressed[more], rest = new(big.Int).QuoRem(rest, moreStep, new(big.Int))
+	if rest.Sign() != 0 || pressed[more].Sign() < 0 {
+		return nil, nil, false, nil
+	}
+	return pressed[0], pressed[1], true, nil
+}
+
+// bigCrossDifference returns a*b - c*d.
+func bigCrossDifference(a, b, c, d *big.Int) *big.Int {
+	ab := new(big.Int).Mul(a, b)
+	return ab.Sub(ab, new(big.Int).Mul(c, d))
+}
@@ -0,0 +1,112 @@
+package day13
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Presses is the witness for day 13: the button presses that win each prize counted in the answer.
+type Presses []MachinePresses
+
+// MachinePresses gives the number of times buttons A and B are pressed to win the prize of a machine.
+type MachinePresses struct {
+	Index int // index of the machine in the input
+	A, B  int
+}
+
+// String shows the presses and cost for each machine.
+func (p Presses) String() string {
+	lines := []string{}
+	for _, presses := range p {
+		lines = append(lines, fmt.Sprintf("machine %d: A %d times, B %d times, %d tokens", presses.Index+1, presses.A,
+			presses.B, 3*presses.A+presses.B))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Certify solves a part of the puzzle along with the button presses for each prize that can be won.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	if part != 1 && part != 2 {
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	total := 0
+	witness := Presses{}
+	for i, machine := range s.machines {
+		if err := ctx.Err(); err != nil {
+			return solver.Answer{}, nil, err
+		}
+		a, b, ok := cheapestPresses(machine)
+		if part == 2 {
+			moved, err := movePrize(machine, s.PrizeOffset)
+			if err != nil {
+				return solver.Answer{}, nil, err
+			}
+			if a, b, ok, err = presses(ctx, moved); err != nil {
+				return solver.Answer{}, nil, err
+			}
+		}
+		if ok {
+			var err error
+			if total, err = addTokens(total, a, b); err != nil {
+				return solver.Answer{}, nil, err
+			}
+			witness = append(witness, MachinePresses{Index: i, A: a, B: b})
+		}
+	}
+	return solver.IntAnswer(total), witness, nil
+}
+
+// Verify checks that the presses for each machine in the witness move the claw exactly onto its prize, and that their
+// costs add up to the answer. When the buttons of a machine move the claw in different directions the presses are the
+// only ones that win the prize; otherwise Verify cannot show that they are the cheapest. It also cannot show that no
+// other prize could be won.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	presses, ok := witness.(Presses)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not day 13 presses", witness)
+	}
+	offset := 0
+	if part == 2 {
+		offset = s.PrizeOffset
+	} else if part != 1 {
+		return solver.ErrNoPart
+	}
+
+	// The sums are worked out with big integers, so that no value can wrap around to look like a prize.
+	total := new(big.Int)
+	seen := map[int]bool{}
+	for _, p := range presses {
+		if p.Index < 0 || p.Index >= len(s.machines) || seen[p.Index] {
+			return fmt.Errorf("machine %d is not in the input or is repeated", p.Index+1)
+		}
+		seen[p.Index] = true
+		if p.A < 0 || p.B < 0 {
+			return fmt.Errorf("machine %d has a negative number of presses", p.Index+1)
+		}
+		if part == 1 && (p.A > 100 || p.B > 100) {
+			return fmt.Errorf("machine %d has a button pressed more than 100 times", p.Index+1)
+		}
+		machine := s.machines[p.Index]
+		a, b := big.NewInt(int64(p.A)), big.NewInt(int64(p.B))
+		for axis := range 2 {
+			position := new(big.Int).Mul(a, big.NewInt(int64(machine.ButtonAMovements[axis])))
+			position.Add(position, new(big.Int).Mul(b, big.NewInt(int64(machine.ButtonBMovements[axis]))))
+			prize := new(big.Int).Add(big.NewInt(int64(machine.PrizePosition[axis])), big.NewInt(int64(offset)))
+			if position.Cmp(prize) != 0 {
+				return fmt.Errorf("machine %d moves the claw to %s on axis %d, not %s", p.Index+1, position, axis+1, prize)
+			}
+		}
+		total.Add(total, a.Mul(a, big.NewInt(3)))
+		total.Add(total, b)
+	}
+
+	if answer.Big() == nil || answer.Big().Cmp(total) != 0 {
+		return fmt.Errorf("the presses cost %d tokens, not %s", total, answer)
+	}
+	return nil
+}
@@ -0,0 +1,347 @@
+package day13
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 13.
+type Solver struct {
+	// PrizeOffset is the distance that the prizes are moved along each axis in part two.
+	PrizeOffset int `param:"prizeOffset"`
+
+	machines []Machine
+	bigInt   bool
+}
+
+// New creates a solver for the puzzle for day 13.
+func New() *Solver {
+	return &Solver{PrizeOffset: 10000000000000}
+}
+
+// Validate checks that the prizes are not moved back past the origin in part two.
+func (s *Solver) Validate() error {
+	return solver.CheckRange("prizeOffset", s.PrizeOffset, 0, math.MaxInt)
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "2"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	machines, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.machines = machines
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.machines)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := bigPartTwo(ctx, s.machines, s.PrizeOffset)
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partTwo(ctx, s.machines, s.PrizeOffset)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, machines []Machine) (int, error) {
+	total := 0
+	for _, machine := range machines {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if a, b, ok := cheapestPresses(machine); ok {
+			total += 3*a + b
+		}
+	}
+	return total, nil
+}
+
+// cheapestPresses finds the cheapest numbers of presses of buttons A and B, each at most 100, that win the prize, if
+// there are any.
+func cheapestPresses(machine Machine) (int, int, bool) {
+	minTokens, minA, minB := 401, 0, 0
+	for i := 0; i <= 100; i++ {
+		for j := 0; j <= 100; j++ {
+			if i*machine.ButtonAMovements[0]+j*machine.ButtonBMovements[0] == machine.PrizePosition[0] &&
+				i*machine.ButtonAMovements[1]+j*machine.ButtonBMovements[1] == machine.PrizePosition[1] &&
+				3*i+j < minTokens {
+				minTokens, minA, minB = 3*i+j, i, j
+			}
+		}
+	}
+	return minA, minB, minTokens < 401
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, machines []Machine, prizeOffset int) (int, error) {
+	total := 0
+	for _, machine := range machines {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		machine, err := movePrize(machine, prizeOffset)
+		if err != nil {
+			return 0, err
+		}
+		a, b, ok, err := presses(ctx, machine)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			if total, err = addTokens(total, a, b); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return total, nil
+}
+
+// movePrize moves the prize of a machine by the offset along each axis.
+func movePrize(machine Machine, prizeOffset int) (Machine, error) {
+	for axis := range machine.PrizePosition {
+		var err error
+		if machine.PrizePosition[axis], err = checked.Add(machine.PrizePosition[axis], prizeOffset); err != nil {
+			return Machine{}, err
+		}
+	}
+	return machine, nil
+}
+
+// presses finds the cheapest numbers of presses of buttons A and B that win the prize, if there are any. When the
+// buttons move the claw in different directions there is only one way to reach the prize, found by solving the pair of
+// equations for the two axes with Cramer's rule.
+func presses(ctx context.Context, machine Machine) (int, int, bool, error) {
+	buttonA, buttonB, prize := machine.ButtonAMovements, machine.ButtonBMovements, machine.PrizePosition
+	determinant, err := crossDifference(buttonA[0], buttonB[1], buttonA[1], buttonB[0])
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if determinant == 0 {
+		return collinearPresses(ctx, machine)
+	}
+	aNumerator, err := crossDifference(prize[0], buttonB[1], prize[1], buttonB[0])
+	if err != nil {
+		return 0, 0, false, err
+	}
+	bNumerator, err := crossDifference(buttonA[0], prize[1], buttonA[1], prize[0])
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if aNumerator%determinant != 0 || bNumerator%determinant != 0 {
+		return 0, 0, false, nil
+	}
+	a, b := aNumerator/determinant, bNumerator/determinant
+	return a, b, a >= 0 && b >= 0, nil
+}
+
+// collinearPresses finds the cheapest presses for a machine whose buttons move the claw along the same line, or do
+// not move it at all. The prize can only be won if it is on that line, and then the problem is the same along one
+// axis.
+func collinearPresses(ctx context.Context, machine Machine) (int, int, bool, error) {
+	buttonA, buttonB, prize := machine.ButtonAMovements, machine.ButtonBMovements, machine.PrizePosition
+	for _, button := range [][2]int{buttonA, buttonB} {
+		cross, err := crossDifference(prize[0], button[1], prize[1], button[0])
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if cross != 0 {
+			return 0, 0, false, nil
+		}
+	}
+	axis := lineAxis(machine)
+	if buttonA[axis] == 0 && buttonB[axis] == 0 {
+		return 0, 0, prize == [2]int{}, nil
+	}
+
+	x, y, distance := buttonA[axis], buttonB[axis], prize[axis]
+	switch {
+	case x == 0:
+		return 0, distance / y, distance%y == 0, nil
+	case y == 0:
+		return distance / x, 0, distance%x == 0, nil
+	case aIsCheaper(x, y):
+		b, ok, err := fewestPresses(ctx, y, distance%x, x)
+		if err != nil || !ok || b > distance/y {
+			return 0, 0, false, err
+		}
+		return (distance - b*y) / x, b, true, nil
+	default:
+		a, ok, err := fewestPresses(ctx, x, distance%y, y)
+		if err != nil || !ok || a > distance/x {
+			return 0, 0, false, err
+		}
+		return a, (distance - a*x) / y, true, nil
+	}
+}
+
+// lineAxis returns an axis along which a button of a machine with collinear buttons moves the claw, or 0 if neither
+// button moves it.
+func lineAxis(machine Machine) int {
+	if machine.ButtonAMovements[0] == 0 && machine.ButtonBMovements[0] == 0 {
+		return 1
+	}
+	return 0
+}
+
+// aIsCheaper reports whether button A, costing 3 tokens to move the claw x along a line, moves it further for each
+// token than button B, costing 1 token to move it y. The cheapest presses then use button A as often as they can.
+func aIsCheaper(x, y int) bool {
+	return (x-1)/3 >= y
+}
+
+// fewestPresses finds the smallest number of presses k for which k*step leaves the given remainder when divided by
+// modulus, if there is one. Only numbers of presses below the modulus need to be tried, as the remainders repeat from
+// there.
+func fewestPresses(ctx context.Context, step, remainder, modulus int) (int, bool, error) {
+	step %= modulus
+	current := 0
+	for k := 0; k < modulus; k++ {
+		if current == remainder {
+			return k, true, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return 0, false, err
+		}
+		current = (current + step) % modulus
+	}
+	return 0, false, nil
+}
+
+// crossDifference returns a*b - c*d.
+func crossDifference(a, b, c, d int) (int, error) {
+	ab, err := checked.Mul(a, b)
+	if err != nil {
+		return 0, err
+	}
+	cd, err := checked.Mul(c, d)
+	if err != nil {
+		return 0, err
+	}
+	return checked.Sub(ab, cd)
+}
+
+// addTokens adds the cost of pressing button A a times and button B b times to a total.
+func addTokens(total, a, b int) (int, error) {
+	cost, err := checked.Mul(3, a)
+	if err != nil {
+		return 0, err
+	}
+	if cost, err = checked.Add(cost, b); err != nil {
+		return 0, err
+	}
+	return checked.Add(total, cost)
+}
+
+type Machine struct {
+	ButtonAMovements [2]int
+	ButtonBMovements [2]int
+	PrizePosition    [2]int
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]Machine, error) {
+	scanner := parse.NewScanner(r)
+
+	machines := []Machine{}
+	for scanner.Scan() {
+		newMachine := Machine{}
+		buttonA, err := readMovements(scanner.Line(), "Button A: X+", ", Y+")
+		if err != nil {
+			return nil, err
+		}
+		newMachine.ButtonAMovements = buttonA
+
+		line, err := scanner.Next(`a line such as "Button B: X+22, Y+67"`)
+		if err != nil {
+			return nil, err
+		}
+		buttonB, err := readMovements(line, "Button B: X+", ", Y+")
+		if err != nil {
+			return nil, err
+		}
+		newMachine.ButtonBMovements = buttonB
+
+		line, err = scanner.Next(`a line such as "Prize: X=8400, Y=5400"`)
+		if err != nil {
+			return nil, err
+		}
+		prize, err := readMovements(line, "Prize: X=", ", Y=")
+		if err != nil {
+			return nil, err
+		}
+		newMachine.PrizePosition = prize
+
+		machines = append(machines, newMachine)
+		if scanner.Scan() && scanner.Text() != "" {
+			return nil, scanner.Line().Invalid("an empty line between machines")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return machines, nil
+}
+
+// readMovements reads the X and Y values from a line of a machine's description.
+func readMovements(line parse.Line, prefix, separator string) ([2]int, error) {
+	line, err := line.TrimPrefix(prefix)
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	xStr, yStr, err := line.Cut(separator, fmt.Sprintf("a number followed by %q", separator))
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	x, err := xStr.Int()
+	if err != nil {
+		return [2]int{}, err
+	}
+	if x < 0 {
+		return [2]int{}, xStr.Invalid("a number that is not negative")
+	}
+
+	y, err := yStr.Int()
+	if err != nil {
+		return [2]int{}, err
+	}
+	if y < 0 {
+		return [2]int{}, yStr.Invalid("a number that is not negative")
+	}
+
+	return [2]int{x, y}, nil
+}
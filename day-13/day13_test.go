@@ -0,0 +1,110 @@
+package day13
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGoldenBigInt(t *testing.T) {
+	solvertest.GoldenBigInt(t, New)
+}
+
+func TestOverflow(t *testing.T) {
+	// The claw has to move 2^62 along each axis, one step per press, costing 2^64 tokens.
+	input := "Button A: X+1, Y+0\nButton B: X+0, Y+1\nPrize: X=0, Y=0\n"
+	s := New()
+	if err := s.Parse(strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+	s.PrizeOffset = 1 << 62
+	if _, err := s.PartTwo(context.Background()); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("got error %v, want %v", err, checked.ErrOverflow)
+	}
+
+	s.SetBigInt(true)
+	answer, err := s.PartTwo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "18446744073709551616"; answer.Kind() != solver.Big || answer.String() != want {
+		t.Errorf("got %s answer %s, want big answer %s", answer.Kind(), answer, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestCertify(t *testing.T) {
+	for part := 1; part <= 2; part++ {
+		answer, witness := solvertest.Certified(t, New, "test_data.txt", nil, part)
+
+		s := New()
+		if err := solvertest.Parse(s, "test_data.txt", nil); err != nil {
+			t.Fatal(err)
+		}
+		presses := slices.Clone(witness.(Presses))
+		presses[0].A++
+		if err := s.Verify(part, answer, presses); err == nil {
+			t.Errorf("part %d: verified a witness with the wrong presses", part)
+		}
+		if err := s.Verify(part, answer, witness.(Presses)[1:]); err == nil {
+			t.Errorf("part %d: verified a witness missing a machine", part)
+		}
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
+
+func TestCollinearButtons(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		// Button B moves the claw further for each token, so it is pressed 10 times.
+		{"Button A: X+2, Y+4\nButton B: X+1, Y+2\nPrize: X=10, Y=20\n", 10},
+		// Button A moves the claw further for each token, so it is pressed as often as the prize allows.
+		{"Button A: X+4, Y+4\nButton B: X+1, Y+1\nPrize: X=10, Y=10\n", 8},
+		{"Button A: X+2, Y+4\nButton B: X+1, Y+2\nPrize: X=10, Y=21\n", 0},
+		{"Button A: X+0, Y+0\nButton B: X+0, Y+3\nPrize: X=0, Y=9\n", 3},
+		{"Button A: X+0, Y+0\nButton B: X+0, Y+0\nPrize: X=0, Y=0\n", 0},
+		// The buttons are not collinear, but button A does not move the claw along X.
+		{"Button A: X+0, Y+1\nButton B: X+1, Y+0\nPrize: X=3, Y=5\n", 18},
+	}
+	for _, test := range tests {
+		for _, bigInt := range []bool{false, true} {
+			s := New()
+			s.PrizeOffset = 0
+			s.SetBigInt(bigInt)
+			if err := s.Parse(strings.NewReader(test.input)); err != nil {
+				t.Fatal(err)
+			}
+			for part := 1; part <= 2; part++ {
+				answer, err := solver.Part(context.Background(), s, part)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if answer.Big().Int64() != int64(test.want) {
+					t.Errorf("%q part %d with bigint %t: got %s, want %d", test.input, part, bigInt, answer, test.want)
+				}
+			}
+		}
+	}
+}
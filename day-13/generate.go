@@ -0,0 +1,89 @@
+package day13
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 13.
+type Generator struct {
+	// Machines is the number of claw machines.
+	Machines int `param:"machines"`
+	// CollinearPercent is the percentage of machines whose buttons move the claw in the same direction, which never
+	// happens in the real puzzle.
+	CollinearPercent int `param:"collinearPercent"`
+	// StillPercent is the percentage of machines with a button that does not move the claw along one axis, or along
+	// either, which never happens in the real puzzle.
+	StillPercent int `param:"stillPercent"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Machines: 320}
+}
+
+// Generate writes a random puzzle input. About half of the prizes can be won with at most 100 presses of each
+// button. Unless CollinearPercent is set, the buttons never move the claw in the same direction, as in the real
+// puzzle. Half of the collinear machines move the claw diagonally, so that their prizes can still be won once they
+// are moved along both axes in part two. Unless StillPercent is set, the buttons always move the claw along both axes.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Machines < 1 {
+		return fmt.Errorf("machines must be at least 1, not %d", g.Machines)
+	}
+	if g.CollinearPercent < 0 || g.CollinearPercent > 100 {
+		return fmt.Errorf("collinearPercent must be from 0 to 100, not %d", g.CollinearPercent)
+	}
+	if g.StillPercent < 0 || g.StillPercent > 100 {
+		return fmt.Errorf("stillPercent must be from 0 to 100, not %d", g.StillPercent)
+	}
+
+	var b strings.Builder
+	for i := 0; i < g.Machines; i++ {
+		var buttonA, buttonB [2]int
+		collinear := rng.IntN(100) < g.CollinearPercent
+		if collinear {
+			direction := [2]int{1, 1}
+			if rng.IntN(2) == 0 {
+				direction = [2]int{1 + rng.IntN(9), 1 + rng.IntN(9)}
+			}
+			aSteps, bSteps := 1+rng.IntN(10), 1+rng.IntN(10)
+			buttonA = [2]int{aSteps * direction[0], aSteps * direction[1]}
+			buttonB = [2]int{bSteps * direction[0], bSteps * direction[1]}
+		}
+		for !collinear && buttonA[0]*buttonB[1] == buttonA[1]*buttonB[0] {
+			buttonA = [2]int{10 + rng.IntN(90), 10 + rng.IntN(90)}
+			buttonB = [2]int{10 + rng.IntN(90), 10 + rng.IntN(90)}
+		}
+		if rng.IntN(100) < g.StillPercent {
+			button := &buttonA
+			if rng.IntN(2) == 0 {
+				button = &buttonB
+			}
+			// Stop the button along the X axis, the Y axis or both.
+			switch rng.IntN(3) {
+			case 0:
+				button[0] = 0
+			case 1:
+				button[1] = 0
+			default:
+				*button = [2]int{}
+			}
+		}
+		aPresses, bPresses := rng.IntN(101), rng.IntN(101)
+		prize := [2]int{aPresses*buttonA[0] + bPresses*buttonB[0], aPresses*buttonA[1] + bPresses*buttonB[1]}
+		if rng.IntN(2) == 0 {
+			prize = [2]int{1000 + rng.IntN(19000), 1000 + rng.IntN(19000)}
+		}
+
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Button A: X+%d, Y+%d\n", buttonA[0], buttonA[1])
+		fmt.Fprintf(&b, "Button B: X+%d, Y+%d\n", buttonB[0], buttonB[1])
+		fmt.Fprintf(&b, "Prize: X=%d, Y=%d\n", prize[0], prize[1])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
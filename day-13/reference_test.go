@@ -0,0 +1,65 @@
+package day13
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+// referencePartTwo solves part two by trying every number of presses of button A, which is only quick enough when
+// the prizes are moved a short distance.
+func referencePartTwo(machines []Machine, prizeOffset int) int {
+	total := 0
+	for _, machine := range machines {
+		buttonA, buttonB := machine.ButtonAMovements, machine.ButtonBMovements
+		prize := [2]int{machine.PrizePosition[0] + prizeOffset, machine.PrizePosition[1] + prizeOffset}
+		// Count the presses of button A along an axis it moves the claw along. If it does not move the claw at all,
+		// pressing it never helps.
+		maxA := 0
+		for axis := range prize {
+			if buttonA[axis] != 0 {
+				maxA = prize[axis] / buttonA[axis]
+				break
+			}
+		}
+		minTokens := -1
+		for a := 0; a <= maxA; a++ {
+			remainder := [2]int{prize[0] - a*buttonA[0], prize[1] - a*buttonA[1]}
+			// Work out the presses of button B along an axis it moves the claw along, if there is one.
+			b := 0
+			for axis := range remainder {
+				if buttonB[axis] != 0 {
+					b = max(remainder[axis]/buttonB[axis], 0)
+					break
+				}
+			}
+			if remainder == [2]int{b * buttonB[0], b * buttonB[1]} && (minTokens == -1 || 3*a+b < minTokens) {
+				minTokens = 3*a + b
+			}
+		}
+		if minTokens != -1 {
+			total += minTokens
+		}
+	}
+	return total
+}
+
+func TestPartTwoMatchesReference(t *testing.T) {
+	for _, prizeOffset := range []int{0, 1, 1000, 54321} {
+		t.Run(fmt.Sprintf("prizeOffset=%d", prizeOffset), func(t *testing.T) {
+			newSolver := func() *Solver {
+				s := New()
+				s.PrizeOffset = prizeOffset
+				return s
+			}
+			solvertest.Differential(t, &Generator{Machines: 20, CollinearPercent: 50, StillPercent: 20}, newSolver,
+				func(s *Solver) (solver.Answer, error) { return s.PartTwo(context.Background()) },
+				func(s *Solver) (solver.Answer, error) {
+					return solver.IntAnswer(referencePartTwo(s.machines, s.PrizeOffset)), nil
+				})
+		})
+	}
+}
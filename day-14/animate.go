@@ -0,0 +1,51 @@
+package day14
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Animate shows the robots moving around the space, second by second: for the 100 seconds of part one, and for
+// part two until they return to where they started, so that the picture of a Christmas tree can be spotted.
+func (s *Solver) Animate(part int) (iter.Seq[animate.Frame], error) {
+	dimensions := [2]int{s.Width, s.Height}
+	switch part {
+	case 1:
+		return robotFrames(s.robots, dimensions, 100, func(robots []Robot) string {
+			return fmt.Sprintf(", safety factor %d", safetyFactor(robots, dimensions))
+		}), nil
+	case 2:
+		return robotFrames(s.robots, dimensions, dimensions[0]*dimensions[1], nil), nil
+	}
+	return nil, animate.ErrNoAnimation
+}
+
+// robotFrames yields a frame for the start and for each second the robots move, with captions that are extended by
+// describe if it is not nil.
+func robotFrames(robots []Robot, dimensions [2]int, seconds int, describe func([]Robot) string) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		for second := 0; second <= seconds; second++ {
+			picture := grid.New(dimensions[0], dimensions[1], byte('.'))
+			for _, robot := range robots {
+				picture.Set(grid.Point{Row: robot.Position[1], Col: robot.Position[0]}, '#')
+			}
+			caption := fmt.Sprintf("second %d", second)
+			if describe != nil {
+				caption += describe(robots)
+			}
+			frame := animate.Frame{Grid: picture, Colours: map[byte]animate.Colour{'#': animate.Green}, Caption: caption}
+			if !yield(frame) {
+				return
+			}
+
+			newRobots := []Robot{}
+			for _, robot := range robots {
+				newRobots = append(newRobots, robot.move(1, dimensions))
+			}
+			robots = newRobots
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package day14
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 14.
+type Solver struct {
+	// Width and Height are the dimensions of the space the robots move in.
+	Width  int `param:"width"`
+	Height int `param:"height"`
+
+	robots []Robot
+}
+
+// New creates a solver for the puzzle for day 14.
+func New() *Solver {
+	return &Solver{Width: 101, Height: 103}
+}
+
+// maxSize is the largest width or height of the space, which keeps the animations to a size that can be drawn.
+const maxSize = 1000
+
+// Validate checks that the space has room for the robots, and is no larger than maxSize in either dimension.
+func (s *Solver) Validate() error {
+	if err := solver.CheckRange("width", s.Width, 1, maxSize); err != nil {
+		return err
+	}
+	return solver.CheckRange("height", s.Height, 1, maxSize)
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "2"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	robots, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.robots = robots
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.robots, [2]int{s.Width, s.Height})
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle. The picture of a Christmas tree cannot be recognized directly, so the answer
+// is the second at which the robots are most bunched together, which is checked by eye in the part's animation.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.robots, [2]int{s.Width, s.Height})
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, robots []Robot, dimensions [2]int) (int, error) {
+	newRobots := []Robot{}
+	for _, robot := range robots {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		newRobots = append(newRobots, robot.move(100, dimensions))
+	}
+	return safetyFactor(newRobots, dimensions), nil
+}
+
+// partTwo finds the first second at which the robots have the lowest safety factor. The robots gather into a picture
+// mostly in one quadrant, which gives a far lower safety factor than at any other second. Their positions repeat
+// after width * height seconds, so later seconds need not be considered.
+func partTwo(ctx context.Context, robots []Robot, dimensions [2]int) (int, error) {
+	robots = slices.Clone(robots)
+	bestSecond, lowestFactor := 0, safetyFactor(robots, dimensions)
+	for second := 1; second < dimensions[0]*dimensions[1]; second++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for i, robot := range robots {
+			robots[i] = robot.move(1, dimensions)
+		}
+		if factor := safetyFactor(robots, dimensions); factor < lowestFactor {
+			bestSecond, lowestFactor = second, factor
+		}
+	}
+	return bestSecond, nil
+}
+
+// safetyFactor multiplies together the numbers of robots in each quadrant of the space.
+func safetyFactor(robots []Robot, dimensions [2]int) int {
+	quadrants := map[int]int{}
+	for _, robot := range robots {
+		if robot.Position[0] < (dimensions[0]-1)/2 && robot.Position[1] < (dimensions[1]-1)/2 {
+			quadrants[1]++
+		} else if robot.Position[0] < (dimensions[0]-1)/2 && robot.Position[1] > (dimensions[1]-1)/2 {
+			quadrants[2]++
+		} else if robot.Position[0] > (dimensions[0]-1)/2 && robot.Position[1] < (dimensions[1]-1)/2 {
+			quadrants[3]++
+		} else if robot.Position[0] > (dimensions[0]-1)/2 && robot.Position[1] > (dimensions[1]-1)/2 {
+			quadrants[4]++
+		}
+	}
+	return quadrants[1] * quadrants[2] * quadrants[3] * quadrants[4]
+}
+
+type Robot struct {
+	Position [2]int
+	Velocity [2]int
+}
+
+// move returns the robot after it has moved for a number of seconds, wrapping around the edges of the space.
+func (robot Robot) move(seconds int, dimensions [2]int) Robot {
+	return Robot{
+		Position: [2]int{((robot.Position[0]+seconds*robot.Velocity[0])%dimensions[0] + dimensions[0]) % dimensions[0],
+			((robot.Position[1]+seconds*robot.Velocity[1])%dimensions[1] + dimensions[1]) % dimensions[1]},
+		Velocity: robot.Velocity,
+	}
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]Robot, error) {
+	scanner := parse.NewScanner(r)
+
+	robots := []Robot{}
+	for scanner.Scan() {
+		positionStr, velocityStr, err := scanner.Line().Cut(" ", `a robot such as "p=0,4 v=3,-3"`)
+		if err != nil {
+			return nil, err
+		}
+
+		position, err := readVector(positionStr, "p=")
+		if err != nil {
+			return nil, err
+		}
+
+		velocity, err := readVector(velocityStr, "v=")
+		if err != nil {
+			return nil, err
+		}
+		robots = append(robots, Robot{Position: position, Velocity: velocity})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return robots, nil
+}
+
+// readVector reads a pair of values such as "p=0,4" from a robot's description.
+func readVector(line parse.Line, prefix string) ([2]int, error) {
+	line, err := line.TrimPrefix(prefix)
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	components, err := line.SplitN(",", 2, "two numbers separated by a comma")
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	x, err := components[0].Int()
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	y, err := components[1].Int()
+	if err != nil {
+		return [2]int{}, err
+	}
+
+	return [2]int{x, y}, nil
+}
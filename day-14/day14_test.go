@@ -0,0 +1,34 @@
+package day14
+
+import (
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestAnimate(t *testing.T) {
+	params := map[string]int{"width": 11, "height": 7}
+	frames := solvertest.Frames(t, New, "test_data.txt", params, 1)
+	if last, want := frames[len(frames)-1].Caption, "second 100, safety factor 12"; last != want {
+		t.Errorf("last frame has caption %q, want %q", last, want)
+	}
+	if frames := solvertest.Frames(t, New, "test_data.txt", params, 2); len(frames) != 78 {
+		t.Errorf("part 2 has %d frames, want one for the start and each of the 77 seconds", len(frames))
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,40 @@
+package day14
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 14.
+type Generator struct {
+	// Robots is the number of robots.
+	Robots int `param:"robots"`
+	// Width and Height are the dimensions of the space the robots move in.
+	Width  int `param:"width"`
+	Height int `param:"height"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Robots: 500, Width: 101, Height: 103}
+}
+
+// Generate writes a random puzzle input.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Robots < 0 {
+		return fmt.Errorf("robots must not be negative, not %d", g.Robots)
+	}
+	if g.Width < 1 || g.Height < 1 {
+		return fmt.Errorf("width and height must be at least 1, not %d and %d", g.Width, g.Height)
+	}
+
+	var b strings.Builder
+	for i := 0; i < g.Robots; i++ {
+		fmt.Fprintf(&b, "p=%d,%d v=%d,%d\n", rng.IntN(g.Width), rng.IntN(g.Height), rng.IntN(2*g.Width-1)-g.Width+1,
+			rng.IntN(2*g.Height-1)-g.Height+1)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,44 @@
+package day15
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// colours are the colours of the cells in the animations.
+var colours = map[byte]animate.Colour{'#': animate.Grey, 'O': animate.Yellow, '[': animate.Yellow, ']': animate.Yellow,
+	'@': animate.Red}
+
+// Animate shows the robot pushing boxes around the warehouse, in the warehouse as given for part one and in the
+// warehouse twice as wide for part two.
+func (s *Solver) Animate(part int) (iter.Seq[animate.Frame], error) {
+	switch part {
+	case 1:
+		return warehouseFrames(s.warehouseMap, s.movements, iterateWarehouse, 'O'), nil
+	case 2:
+		return warehouseFrames(resizeMap(s.warehouseMap), s.movements, iterateResizedWarehouse, '['), nil
+	}
+	return nil, animate.ErrNoAnimation
+}
+
+// warehouseFrames yields a frame for the start and after each movement of the robot, made with iterate, along with
+// the sum of the coordinates of the boxes.
+func warehouseFrames(warehouseMap *grid.Grid[byte], movements []grid.Direction,
+	iterate func(*grid.Grid[byte], grid.Direction) *grid.Grid[byte], box byte) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		for i := 0; i <= len(movements); i++ {
+			caption := "start"
+			if i > 0 {
+				warehouseMap = iterate(warehouseMap, movements[i-1])
+				caption = fmt.Sprintf("move %d of %d (%c)", i, len(movements), movements[i-1].Arrow())
+			}
+			caption += fmt.Sprintf(", coordinates sum to %d", sumCoordinates(warehouseMap, box))
+			if !yield(animate.Frame{Grid: warehouseMap, Colours: colours, Caption: caption}) {
+				return
+			}
+		}
+	}
+}
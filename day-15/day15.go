@@ -0,0 +1,227 @@
+package day15
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 15.
+type Solver struct {
+	warehouseMap *grid.Grid[byte]
+	movements    []grid.Direction
+}
+
+// New creates a solver for the puzzle for day 15.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	warehouseMap, movements, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.warehouseMap, s.movements = warehouseMap, movements
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.warehouseMap, s.movements)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.warehouseMap, s.movements)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, warehouseMap *grid.Grid[byte], movements []grid.Direction) (int, error) {
+	tracer := events.FromContext(ctx)
+	for i, movement := range movements {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		warehouseMap = iterateWarehouse(warehouseMap, movement)
+		traceMove(tracer, warehouseMap, i, movement)
+	}
+	return sumCoordinates(warehouseMap, 'O'), nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, warehouseMap *grid.Grid[byte], movements []grid.Direction) (int, error) {
+	tracer := events.FromContext(ctx)
+	resizedMap := resizeMap(warehouseMap)
+	for i, movement := range movements {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		resizedMap = iterateResizedWarehouse(resizedMap, movement)
+		traceMove(tracer, resizedMap, i, movement)
+	}
+	return sumCoordinates(resizedMap, '['), nil
+}
+
+// traceMove reports the movement with the given index to the tracer as a move event, with the position of the robot
+// once it has been applied.
+func traceMove(tracer events.Tracer, warehouseMap *grid.Grid[byte], index int, movement grid.Direction) {
+	if !tracer.Enabled() {
+		return
+	}
+	robotPosition, _ := warehouseMap.Find('@')
+	tracer.Emit("move", events.Int("move", index+1), events.String("direction", movement.String()),
+		events.Int("row", robotPosition.Row), events.Int("col", robotPosition.Col))
+}
+
+// iterateWarehouse performs the movement.
+func iterateWarehouse(warehouseMap *grid.Grid[byte], movement grid.Direction) *grid.Grid[byte] {
+	robotPosition, _ := warehouseMap.Find('@')
+	positionsToMove := getPositionsToMove(warehouseMap, robotPosition, movement)
+	return move(warehouseMap, positionsToMove, movement)
+}
+
+// move creates a copy of the warehouse with the positions moved one step in the movement direction, in reverse order.
+func move(warehouseMap *grid.Grid[byte], positionsToMove []grid.Point, movement grid.Direction) *grid.Grid[byte] {
+	warehouseCopy := warehouseMap.Clone()
+	for i := len(positionsToMove) - 1; i >= 0; i-- {
+		warehouseCopy.Set(positionsToMove[i].Move(movement), warehouseCopy.At(positionsToMove[i]))
+		warehouseCopy.Set(positionsToMove[i], '.')
+	}
+	return warehouseCopy
+}
+
+// getPositionsToMove gets all positions in the given movement direction up to the first wall or empty space.
+func getPositionsToMove(warehouseMap *grid.Grid[byte], robotPosition grid.Point, movement grid.Direction) []grid.Point {
+	positions := []grid.Point{robotPosition}
+	for {
+		nextPosition := positions[len(positions)-1].Move(movement)
+		if warehouseMap.At(nextPosition) == '.' {
+			return positions
+		} else if warehouseMap.At(nextPosition) == '#' {
+			return []grid.Point{}
+		}
+		positions = append(positions, nextPosition)
+	}
+}
+
+// sumCoordinates sums the coordinates of the boxes in the warehouse, using the given cell to locate each box.
+func sumCoordinates(warehouseMap *grid.Grid[byte], box byte) int {
+	total := 0
+	for _, position := range warehouseMap.FindAll(box) {
+		total += position.Row*100 + position.Col
+	}
+	return total
+}
+
+// resizeMap converts a warehouse map to the larger size.
+func resizeMap(warehouseMap *grid.Grid[byte]) *grid.Grid[byte] {
+	newMap := grid.New(warehouseMap.Width()*2, warehouseMap.Height(), byte('.'))
+	for position, cell := range warehouseMap.All() {
+		left, right := grid.Point{Row: position.Row, Col: 2 * position.Col}, grid.Point{Row: position.Row, Col: 2*position.Col + 1}
+		switch cell {
+		case '#':
+			newMap.Set(left, '#')
+			newMap.Set(right, '#')
+		case 'O':
+			newMap.Set(left, '[')
+			newMap.Set(right, ']')
+		case '@':
+			newMap.Set(left, '@')
+		}
+	}
+	return newMap
+}
+
+// iterateResizedWarehouse performs the movement on the resized warehouse.
+func iterateResizedWarehouse(warehouseMap *grid.Grid[byte], movement grid.Direction) *grid.Grid[byte] {
+	robotPosition, _ := warehouseMap.Find('@')
+	positionsToMove := getResizedPositionsToMove(warehouseMap, robotPosition, movement)
+	return move(warehouseMap, positionsToMove, movement)
+}
+
+// getResizedPositionsToMove gets all positions in the given movement direction up to the first wall or empty space.
+func getResizedPositionsToMove(warehouseMap *grid.Grid[byte], robotPosition grid.Point, movement grid.Direction) []grid.Point {
+	vertical := movement == grid.North || movement == grid.South
+	positions := []grid.Point{robotPosition}
+	positionsToConsider := map[grid.Point]bool{robotPosition: true}
+	for {
+		newPositions := map[grid.Point]bool{}
+		empty := true
+		for positionToConsider := range positionsToConsider {
+			nextPosition := positionToConsider.Move(movement)
+			cell := warehouseMap.At(nextPosition)
+			if cell == '#' {
+				return []grid.Point{}
+			}
+			if cell != '.' {
+				empty = false
+				newPositions[nextPosition] = true
+			}
+			if vertical && cell == '[' {
+				newPositions[nextPosition.Move(grid.East)] = true
+			} else if vertical && cell == ']' {
+				newPositions[nextPosition.Move(grid.West)] = true
+			}
+		}
+		if empty {
+			return positions
+		}
+
+		for newPosition := range newPositions {
+			positions = append(positions, newPosition)
+		}
+		positionsToConsider = newPositions
+	}
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], []grid.Direction, error) {
+	scanner := parse.NewScanner(r)
+	warehouseMap, err := parse.WalledGrid(scanner, "#.O@", '#')
+	if err != nil {
+		return nil, nil, err
+	}
+
+	movements := []grid.Direction{}
+	for scanner.Scan() {
+		line := scanner.Line()
+		if err := line.OneOf("^>v<"); err != nil {
+			return nil, nil, err
+		}
+		for _, arrow := range line.Text {
+			movement, _ := grid.DirectionFromArrow(arrow)
+			movements = append(movements, movement)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := warehouseMap.Find('@'); !ok {
+		return nil, nil, errors.New("warehouse has no robot")
+	}
+	return warehouseMap, movements, nil
+}
@@ -0,0 +1,33 @@
+package day15
+
+import (
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestAnimate(t *testing.T) {
+	for part, want := range map[int]string{1: "move 700 of 700 (^), coordinates sum to 10092",
+		2: "move 700 of 700 (^), coordinates sum to 9021"} {
+		frames := solvertest.Frames(t, New, "test_data.txt", nil, part)
+		if last := frames[len(frames)-1].Caption; last != want {
+			t.Errorf("part %d: last frame has caption %q, want %q", part, last, want)
+		}
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,67 @@
+package day15
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 15.
+type Generator struct {
+	// Size is the width and height of the warehouse, including its surrounding wall.
+	Size int `param:"size"`
+	// WallPercent and BoxPercent are the percentages of the floor covered by walls and boxes.
+	WallPercent int `param:"wallPercent"`
+	BoxPercent  int `param:"boxPercent"`
+	// Movements is the number of movements the robot attempts.
+	Movements int `param:"movements"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 50, WallPercent: 8, BoxPercent: 25, Movements: 20000}
+}
+
+// Generate writes a random puzzle input.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 3 {
+		return fmt.Errorf("size must be at least 3, not %d", g.Size)
+	}
+	if g.WallPercent < 0 || g.BoxPercent < 0 || g.WallPercent+g.BoxPercent > 100 {
+		return fmt.Errorf("wallPercent and boxPercent must not be negative or add up to more than 100")
+	}
+	if g.Movements < 0 {
+		return fmt.Errorf("movements must not be negative, not %d", g.Movements)
+	}
+
+	warehouseMap := grid.New[byte](g.Size, g.Size, '#')
+	for position := range warehouseMap.All() {
+		if position.Row == 0 || position.Col == 0 || position.Row == g.Size-1 || position.Col == g.Size-1 {
+			continue
+		}
+		switch n := rng.IntN(100); {
+		case n < g.WallPercent:
+			warehouseMap.Set(position, '#')
+		case n < g.WallPercent+g.BoxPercent:
+			warehouseMap.Set(position, 'O')
+		default:
+			warehouseMap.Set(position, '.')
+		}
+	}
+	warehouseMap.Set(grid.Point{Row: 1 + rng.IntN(g.Size-2), Col: 1 + rng.IntN(g.Size-2)}, '@')
+
+	var b strings.Builder
+	b.WriteString(warehouseMap.String())
+	for i := 0; i < g.Movements; i++ {
+		if i%1000 == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteRune(grid.Directions[rng.IntN(len(grid.Directions))].Arrow())
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
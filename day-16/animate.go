@@ -0,0 +1,110 @@
+package day16
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// colours are the colours of the cells in the animations.
+var colours = map[byte]animate.Colour{'#': animate.Grey, 'S': animate.Red, 'E': animate.Red, '+': animate.Blue,
+	'*': animate.Yellow, 'O': animate.Green}
+
+// Animate shows the search spreading out through the maze to find the lowest score for part one, and the best paths
+// being followed from the start for part two.
+func (s *Solver) Animate(part int) (iter.Seq[animate.Frame], error) {
+	switch part {
+	case 1:
+		return searchFrames(s.maze), nil
+	case 2:
+		return bestRouteFrames(s.maze), nil
+	}
+	return nil, animate.ErrNoAnimation
+}
+
+// searchFrames yields a frame for each round of the search for the lowest scores, marking the positions reached so
+// far and those still to be expanded, and finally a frame with the lowest score.
+func searchFrames(maze *grid.Grid[byte]) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		startPosition, _ := maze.Find('S')
+		endPosition, _ := maze.Find('E')
+		startLocation := Location{Position: startPosition, Direction: grid.East}
+		locationsToConsider := []Location{startLocation}
+		bestPoints := map[Location]int{startLocation: 0}
+		picture := maze.Clone()
+		for round := 1; len(locationsToConsider) > 0; round++ {
+			newLocationsToConsider := []Location{}
+			for _, location := range locationsToConsider {
+				newLocationsToConsider = append(newLocationsToConsider,
+					expand(location, bestPoints, maze, endPosition, events.Discard)...)
+			}
+			locationsToConsider = newLocationsToConsider
+
+			frame := animate.Frame{Grid: picture.Clone(), Colours: colours,
+				Caption: fmt.Sprintf("round %d: %d locations to expand", round, len(locationsToConsider))}
+			for _, location := range locationsToConsider {
+				frame.Grid.Set(location.Position, '*')
+			}
+			if !yield(frame) {
+				return
+			}
+			for _, location := range locationsToConsider {
+				picture.Set(location.Position, '+')
+			}
+		}
+		yield(animate.Frame{Grid: picture, Colours: colours,
+			Caption: fmt.Sprintf("lowest score %d", lowestPointsAtPosition(bestPoints, endPosition))})
+	}
+}
+
+// bestRouteFrames yields a frame for each step along the paths that might be best, marking the tiles they cover, and
+// finally a frame marking the tiles on the best paths.
+func bestRouteFrames(maze *grid.Grid[byte]) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		startPosition, _ := maze.Find('S')
+		endPosition, _ := maze.Find('E')
+		// The search cannot fail, as the context is never cancelled.
+		lowestPoints, _ := lowestPoints(context.Background(), Location{Position: startPosition, Direction: grid.East}, maze)
+		routesToConsider := []Route{{
+			Positions:        map[grid.Point]bool{startPosition: true},
+			CurrentPosition:  startPosition,
+			CurrentDirection: grid.East,
+		}}
+		picture := maze.Clone()
+		bestTiles := map[grid.Point]bool{}
+		for steps := 1; len(routesToConsider) > 0; steps++ {
+			newRoutesToConsider := []Route{}
+			for _, route := range routesToConsider {
+				unfinishedRoutes, finishedRoutes := extend(route, lowestPoints, maze, endPosition)
+				newRoutesToConsider = append(newRoutesToConsider, unfinishedRoutes...)
+				for _, newRoute := range unfinishedRoutes {
+					picture.Set(newRoute.CurrentPosition, '+')
+				}
+				for _, bestRoute := range finishedRoutes {
+					for position := range bestRoute.Positions {
+						bestTiles[position] = true
+					}
+				}
+			}
+			routesToConsider = newRoutesToConsider
+
+			frame := animate.Frame{Grid: picture.Clone(), Colours: colours,
+				Caption: fmt.Sprintf("step %d: following %d paths", steps, len(routesToConsider))}
+			if !yield(frame) {
+				return
+			}
+		}
+
+		for position := range bestTiles {
+			if picture.At(position) == '+' {
+				picture.Set(position, 'O')
+			}
+		}
+		yield(animate.Frame{Grid: picture, Colours: colours,
+			Caption: fmt.Sprintf("%d tiles on the best paths", len(bestTiles))})
+	}
+}
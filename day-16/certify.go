@@ -0,0 +1,149 @@
+package day16
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Path is the witness for part one of day 16: a path through the maze from the start to the end that scores the
+// answer.
+type Path struct {
+	Maze      *grid.Grid[byte]
+	Positions []grid.Point // the tiles along the path, from S to E
+}
+
+// String draws the path on the maze, with an arrow on each tile showing the way the reindeer leaves it and a '+' on
+// each tile where it turns.
+func (p Path) String() string {
+	maze := p.Maze.Clone()
+	direction := grid.East
+	steps, turns := 0, 0
+	for i := 1; i < len(p.Positions); i++ {
+		next := directionBetween(p.Positions[i-1], p.Positions[i])
+		mark := byte(next.Arrow())
+		if next != direction {
+			mark = '+'
+			turns += direction.Turns(next)
+		}
+		if i > 1 {
+			maze.Set(p.Positions[i-1], mark)
+		}
+		direction = next
+		steps++
+	}
+	return maze.String() + fmt.Sprintf("%d steps and %d turns", steps, turns)
+}
+
+// directionBetween returns the direction of a step from one tile to an adjacent one, or north if they are not
+// adjacent.
+func directionBetween(from, to grid.Point) grid.Direction {
+	for _, direction := range grid.Directions {
+		if from.Move(direction) == to {
+			return direction
+		}
+	}
+	return grid.North
+}
+
+// Certify solves a part of the puzzle along with a path with the lowest score for part one.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	switch part {
+	case 1:
+	case 2:
+		return solver.Answer{}, nil, solver.ErrNoWitness
+	default:
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	startPosition, _ := s.maze.Find('S')
+	endPosition, _ := s.maze.Find('E')
+	startLocation := Location{Position: startPosition, Direction: grid.East}
+	lowestPoints, err := lowestPoints(ctx, startLocation, s.maze)
+	if err != nil {
+		return solver.Answer{}, nil, err
+	}
+	score := lowestPointsAtPosition(lowestPoints, endPosition)
+	if score == -1 {
+		return solver.Answer{}, nil, errors.New("the end cannot be reached")
+	}
+
+	// Walk back from the end, at each tile stepping back to a location whose score leads to the current one.
+	location := Location{Position: endPosition}
+	for _, direction := range grid.Directions {
+		if points, ok := lowestPoints[Location{Position: endPosition, Direction: direction}]; ok && points == score {
+			location.Direction = direction
+			break
+		}
+	}
+	positions := []grid.Point{endPosition}
+	for location != startLocation {
+		previous := location.Position.Move(location.Direction.Reverse())
+		for _, direction := range grid.Directions {
+			points, ok := lowestPoints[Location{Position: previous, Direction: direction}]
+			if ok && points+1+1000*direction.Turns(location.Direction) == lowestPoints[location] {
+				location = Location{Position: previous, Direction: direction}
+				break
+			}
+		}
+		positions = append(positions, previous)
+	}
+	slices.Reverse(positions)
+	return solver.IntAnswer(score), Path{Maze: s.maze, Positions: positions}, nil
+}
+
+// Verify checks that the path in the witness leads through open tiles from the start to the end, and that stepping
+// and turning along it, starting out facing east, scores the answer. It cannot show that no path scores less.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	switch part {
+	case 1:
+	case 2:
+		return solver.ErrNoWitness
+	default:
+		return solver.ErrNoPart
+	}
+	path, ok := witness.(Path)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not a day 16 path", witness)
+	}
+	if len(path.Positions) == 0 {
+		return errors.New("the path is empty")
+	}
+	if start := path.Positions[0]; !s.maze.InBounds(start) || s.maze.At(start) != 'S' {
+		return fmt.Errorf("the path starts at %v, not the start", start)
+	}
+	if end := path.Positions[len(path.Positions)-1]; !s.maze.InBounds(end) || s.maze.At(end) != 'E' {
+		return fmt.Errorf("the path ends at %v, not the end", end)
+	}
+
+	score := 0
+	facing := grid.Point{Row: 0, Col: 1}
+	for i := 1; i < len(path.Positions); i++ {
+		from, to := path.Positions[i-1], path.Positions[i]
+		if tile, ok := s.maze.Get(to); !ok || tile == '#' {
+			return fmt.Errorf("the path goes through %v, which is not an open tile", to)
+		}
+		step := to.Sub(from)
+		if from.Manhattan(to) != 1 {
+			return fmt.Errorf("the path jumps from %v to %v", from, to)
+		}
+		switch step {
+		case facing:
+		case facing.Scale(-1):
+			score += 2000
+		default:
+			score += 1000
+		}
+		score++
+		facing = step
+	}
+
+	if answer.Kind() != solver.Int || answer.Int() != score {
+		return fmt.Errorf("the path scores %d, not %s", score, answer)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package day16
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 16.
+type Solver struct {
+	maze *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 16.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	maze, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.maze = maze
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.maze)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.maze)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+type Location struct {
+	Position  grid.Point
+	Direction grid.Direction
+}
+
+type Route struct {
+	Positions        map[grid.Point]bool
+	CurrentPosition  grid.Point
+	CurrentDirection grid.Direction
+	Points           int
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, maze *grid.Grid[byte]) (int, error) {
+	startPosition, _ := maze.Find('S')
+	endPosition, _ := maze.Find('E')
+	startLocation := Location{
+		Position:  startPosition,
+		Direction: grid.East,
+	}
+	lowestPoints, err := lowestPoints(ctx, startLocation, maze)
+	if err != nil {
+		return 0, err
+	}
+	return lowestPointsAtPosition(lowestPoints, endPosition), nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, maze *grid.Grid[byte]) (int, error) {
+	startPosition, _ := maze.Find('S')
+	startLocation := Location{
+		Position:  startPosition,
+		Direction: grid.East,
+	}
+	lowestPoints, err := lowestPoints(ctx, startLocation, maze)
+	if err != nil {
+		return 0, err
+	}
+	startRoute := Route{
+		Positions:        map[grid.Point]bool{startPosition: true},
+		CurrentPosition:  startPosition,
+		CurrentDirection: grid.East,
+		Points:           0,
+	}
+	bestRoutes, err := findBestRoutes(ctx, startRoute, lowestPoints, maze)
+	if err != nil {
+		return 0, err
+	}
+	positions := map[grid.Point]bool{}
+	for _, route := range bestRoutes {
+		for position := range route.Positions {
+			positions[position] = true
+		}
+	}
+	return len(positions), nil
+}
+
+// lowestPoints finds the lowest score achievable for each location.
+func lowestPoints(ctx context.Context, startLocation Location, maze *grid.Grid[byte]) (map[Location]int, error) {
+	tracer := events.FromContext(ctx)
+	endPosition, _ := maze.Find('E')
+	locationsToConsider := []Location{startLocation}
+	bestPoints := map[Location]int{startLocation: 0}
+	for len(locationsToConsider) > 0 {
+		newLocationsToConsider := []Location{}
+		for _, location := range locationsToConsider {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			newLocationsToConsider = append(newLocationsToConsider,
+				expand(location, bestPoints, maze, endPosition, tracer)...)
+		}
+		locationsToConsider = newLocationsToConsider
+	}
+	return bestPoints, nil
+}
+
+// expand lowers the scores of the locations reachable in one step from a location, where that location gives a lower
+// score than was known, and returns those other than the end, which need expanding in turn. Each lowered score is
+// reported to the tracer as a relax event.
+func expand(location Location, bestPoints map[Location]int, maze *grid.Grid[byte], endPosition grid.Point,
+	tracer events.Tracer) []Location {
+	newLocations := []Location{}
+	for _, direction := range grid.Directions {
+		adjacentPosition := location.Position.Move(direction)
+		if maze.At(adjacentPosition) != '#' {
+			newPoints := bestPoints[location] + 1 + 1000*location.Direction.Turns(direction)
+			if best, ok := bestPoints[Location{Position: adjacentPosition, Direction: direction}]; !ok || newPoints < best {
+				bestPoints[Location{Position: adjacentPosition, Direction: direction}] = newPoints
+				if tracer.Enabled() {
+					tracer.Emit("relax", events.Int("row", adjacentPosition.Row), events.Int("col", adjacentPosition.Col),
+						events.String("direction", direction.String()), events.Int("points", newPoints))
+				}
+				if adjacentPosition != endPosition {
+					newLocations = append(newLocations, Location{Position: adjacentPosition, Direction: direction})
+				}
+			}
+		}
+	}
+	return newLocations
+}
+
+// lowestPointsAtPosition finds the lowest possible points needed to reach a certain position.
+func lowestPointsAtPosition(lowestPoints map[Location]int, position grid.Point) int {
+	lowestScore := -1
+	for _, direction := range grid.Directions {
+		if val, ok := lowestPoints[Location{Position: position, Direction: direction}]; ok && (lowestScore == -1 || val < lowestScore) {
+			lowestScore = val
+		}
+	}
+	return lowestScore
+}
+
+// findBestRoutes constructs the bestRoutes from the given start route.
+func findBestRoutes(ctx context.Context, route Route, lowestPoints map[Location]int, maze *grid.Grid[byte]) ([]Route, error) {
+	routesToConsider := []Route{route}
+	bestRoutes := []Route{}
+	endPosition, _ := maze.Find('E')
+	for len(routesToConsider) > 0 {
+		newRoutesToConsider := []Route{}
+		for _, routeToConsider := range routesToConsider {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			unfinishedRoutes, finishedRoutes := extend(routeToConsider, lowestPoints, maze, endPosition)
+			newRoutesToConsider = append(newRoutesToConsider, unfinishedRoutes...)
+			bestRoutes = append(bestRoutes, finishedRoutes...)
+		}
+		routesToConsider = newRoutesToConsider
+	}
+	return bestRoutes, nil
+}
+
+// extend extends a route by one step in every direction that keeps it on a lowest scoring path. It returns the
+// routes that have yet to reach the end, and those that reach it with the lowest possible score.
+func extend(route Route, lowestPoints map[Location]int, maze *grid.Grid[byte], endPosition grid.Point) ([]Route, []Route) {
+	unfinishedRoutes, finishedRoutes := []Route{}, []Route{}
+	for _, direction := range grid.Directions {
+		adjacentPosition := route.CurrentPosition.Move(direction)
+		if maze.At(adjacentPosition) != '#' {
+			newPoints := route.Points + 1 + 1000*route.CurrentDirection.Turns(direction)
+			if newPoints == lowestPoints[Location{Position: adjacentPosition, Direction: direction}] {
+				newPositions := copyMap(route.Positions)
+				newPositions[adjacentPosition] = true
+				newRoute := Route{
+					Positions:        newPositions,
+					CurrentPosition:  adjacentPosition,
+					CurrentDirection: direction,
+					Points:           newPoints,
+				}
+				if adjacentPosition != endPosition {
+					unfinishedRoutes = append(unfinishedRoutes, newRoute)
+				} else if newPoints == lowestPointsAtPosition(lowestPoints, endPosition) {
+					finishedRoutes = append(finishedRoutes, newRoute)
+				}
+			}
+		}
+	}
+	return unfinishedRoutes, finishedRoutes
+}
+
+// copyMap creates a copy of a map.
+func copyMap(oldMap map[grid.Point]bool) map[grid.Point]bool {
+	newMap := map[grid.Point]bool{}
+	for k, v := range oldMap {
+		newMap[k] = v
+	}
+	return newMap
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	maze, err := parse.WalledGrid(scanner, "#.SE", '#')
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	if _, ok := maze.Find('S'); !ok {
+		return nil, errors.New("maze has no start")
+	}
+	if _, ok := maze.Find('E'); !ok {
+		return nil, errors.New("maze has no end")
+	}
+	return maze, nil
+}
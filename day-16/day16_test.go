@@ -0,0 +1,60 @@
+package day16
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestAnimate(t *testing.T) {
+	for part, want := range map[int]string{1: "lowest score 7036", 2: "45 tiles on the best paths"} {
+		frames := solvertest.Frames(t, New, "test_data.txt", nil, part)
+		if last := frames[len(frames)-1].Caption; last != want {
+			t.Errorf("part %d: last frame has caption %q, want %q", part, last, want)
+		}
+	}
+}
+
+func TestCertify(t *testing.T) {
+	answer, witness := solvertest.Certified(t, New, "test_data.txt", nil, 1)
+	if !strings.HasSuffix(witness.String(), "36 steps and 7 turns") {
+		t.Errorf("got path\n%s\nwant one of 36 steps and 7 turns", witness)
+	}
+
+	s := New()
+	if err := solvertest.Parse(s, "test_data.txt", nil); err != nil {
+		t.Fatal(err)
+	}
+	path := witness.(Path)
+	shortened := Path{Maze: path.Maze, Positions: slices.Delete(slices.Clone(path.Positions), 1, 2)}
+	if err := s.Verify(1, answer, shortened); err == nil {
+		t.Error("verified a path that jumps a tile")
+	}
+	if err := s.Verify(1, answer, Path{Maze: path.Maze, Positions: path.Positions[:len(path.Positions)-1]}); err == nil {
+		t.Error("verified a path that stops short of the end")
+	}
+	if _, _, err := s.Certify(context.Background(), 2); !errors.Is(err, solver.ErrNoWitness) {
+		t.Errorf("got error %v for part 2, want %v", err, solver.ErrNoWitness)
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,46 @@
+package day16
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/gen"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 16.
+type Generator struct {
+	// Size is the width and height of the maze, which must be odd.
+	Size int `param:"size"`
+	// LoopPercent is the percentage of inner walls knocked down to make more than one route.
+	LoopPercent int `param:"loopPercent"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 141, LoopPercent: 10}
+}
+
+// Generate writes a random puzzle input, with the start in the bottom left corner and the end in the top right.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 5 || g.Size%2 == 0 {
+		return fmt.Errorf("size must be odd and at least 5, not %d", g.Size)
+	}
+	if g.LoopPercent < 0 || g.LoopPercent > 100 {
+		return fmt.Errorf("loopPercent must be from 0 to 100, not %d", g.LoopPercent)
+	}
+
+	maze := gen.Maze(g.Size, g.Size, rng)
+	for position, cell := range maze.All() {
+		// The walls between two cells of the maze are those with one odd and one even coordinate.
+		inner := position.Row > 0 && position.Col > 0 && position.Row < g.Size-1 && position.Col < g.Size-1
+		if inner && cell == '#' && (position.Row+position.Col)%2 == 1 && rng.IntN(100) < g.LoopPercent {
+			maze.Set(position, '.')
+		}
+	}
+	maze.Set(grid.Point{Row: g.Size - 2, Col: 1}, 'S')
+	maze.Set(grid.Point{Row: 1, Col: g.Size - 2}, 'E')
+	_, err := maze.WriteTo(w)
+	return err
+}
@@ -0,0 +1,338 @@
+package day17
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 17.
+type Solver struct {
+	computer Computer
+}
+
+// New creates a solver for the puzzle for day 17.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "2"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	computer, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.computer = computer
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.computer)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.StringAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.computer)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+type Computer struct {
+	Registers          [3]int
+	Program            []int
+	InstructionPointer int
+	Outputs            []int
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, computer Computer) (string, error) {
+	outputs, err := run(ctx, computer)
+	if err != nil {
+		return "", err
+	}
+
+	return constructOutputString(outputs), nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, computer Computer) (int, error) {
+	return smallestStart(ctx, computer.Program, computer.Program)
+}
+
+// run runs a copy of the computer until it halts and returns its outputs.
+func run(ctx context.Context, computer Computer) ([]int, error) {
+	tracer := events.FromContext(ctx)
+	c := computer.copy()
+	for !c.halted() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		c.performOperation(tracer)
+	}
+	return c.Outputs, nil
+}
+
+// smallestStart finds the smallest value of register A for which the program produces the given outputs, or -1 if
+// there is none.
+func smallestStart(ctx context.Context, program, outputs []int) (int, error) {
+	computers := []Computer{}
+	for i := 0; i < 8; i++ {
+		starts, err := findValidStarts(ctx, [3]int{0, 0, i}, program, outputs)
+		if err != nil {
+			return 0, err
+		}
+		computers = append(computers, starts...)
+	}
+
+	min := -1
+	for _, comp := range computers {
+		if min == -1 || comp.Registers[0] < min {
+			min = comp.Registers[0]
+		}
+	}
+	return min, nil
+}
+
+// halted reports whether the computer has stopped, as its instruction pointer has no instruction and operand to read.
+func (c *Computer) halted() bool {
+	return c.InstructionPointer < 0 || c.InstructionPointer+1 >= len(c.Program)
+}
+
+// copy creates a copy of the computer.
+func (c *Computer) copy() Computer {
+	program := make([]int, len(c.Program))
+	copy(program, c.Program)
+	return Computer{
+		Program:   program,
+		Registers: c.Registers,
+	}
+}
+
+// opcodeNames are the names of the instructions, indexed by opcode.
+var opcodeNames = [8]string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
+// performOperation performs a computer operation, reporting it to the tracer as an execute event with the registers
+// as they were beforehand.
+func (c *Computer) performOperation(tracer events.Tracer) {
+	if tracer.Enabled() {
+		opcode := c.Program[c.InstructionPointer]
+		tracer.Emit("execute", events.Int("ip", c.InstructionPointer), events.String("opcode", opcodeNames[opcode]),
+			events.Int("operand", c.Program[c.InstructionPointer+1]), events.Int("a", c.Registers[0]),
+			events.Int("b", c.Registers[1]), events.Int("c", c.Registers[2]))
+	}
+	switch c.Program[c.InstructionPointer] {
+	case 0:
+		c.adv()
+	case 1:
+		c.bxl()
+	case 2:
+		c.bst()
+	case 3:
+		c.jnz()
+	case 4:
+		c.bxc()
+	case 5:
+		c.out()
+	case 6:
+		c.bdv()
+	case 7:
+		c.cdv()
+	}
+}
+
+// adv performs the adv operation. Dividing by a power of two is done as a shift, which gives 0 rather than dividing
+// by zero once the power is too large for an int.
+func (c *Computer) adv() {
+	numerator := c.Registers[0]
+	operand := c.comboOperand()
+	c.Registers[0] = numerator >> operand
+	c.InstructionPointer += 2
+}
+
+// bxl performs the bxl operation.
+func (c *Computer) bxl() {
+	c.Registers[1] = c.Registers[1] ^ c.Program[c.InstructionPointer+1]
+	c.InstructionPointer += 2
+}
+
+// bst performs the bst operation.
+func (c *Computer) bst() {
+	c.Registers[1] = c.comboOperand() % 8
+	c.InstructionPointer += 2
+}
+
+// jnz performs the jnz operation.
+func (c *Computer) jnz() {
+	if c.Registers[0] != 0 {
+		c.InstructionPointer = c.Program[c.InstructionPointer+1]
+	} else {
+		c.InstructionPointer += 2
+	}
+}
+
+// bxc performs the bxc operation.
+func (c *Computer) bxc() {
+	c.Registers[1] = c.Registers[1] ^ c.Registers[2]
+	c.InstructionPointer += 2
+}
+
+// out performs the out operation.
+func (c *Computer) out() {
+	operand := c.comboOperand() % 8
+	c.Outputs = append(c.Outputs, operand)
+	c.InstructionPointer += 2
+}
+
+// bdv performs the bdv operation.
+func (c *Computer) bdv() {
+	numerator := c.Registers[0]
+	operand := c.comboOperand()
+	c.Registers[1] = numerator >> operand
+	c.InstructionPointer += 2
+}
+
+// cdv performs the cdv operation.
+func (c *Computer) cdv() {
+	numerator := c.Registers[0]
+	operand := c.comboOperand()
+	c.Registers[2] = numerator >> operand
+	c.InstructionPointer += 2
+}
+
+// comboOperand calculates the combo operand.
+func (c *Computer) comboOperand() int {
+	operand := c.Program[c.InstructionPointer+1]
+	if operand == 4 {
+		operand = c.Registers[0]
+	} else if operand == 5 {
+		operand = c.Registers[1]
+	} else if operand == 6 {
+		operand = c.Registers[2]
+	}
+	return operand
+}
+
+// constructOutputString constructs a comma-separated string from a computer output.
+func constructOutputString(program []int) string {
+	outputStr := []string{}
+	for _, value := range program {
+		outputStr = append(outputStr, strconv.Itoa(value))
+	}
+	return strings.Join(outputStr, ",")
+}
+
+// findValidStarts finds all computers that produce the given end registers and outputs.
+// It assumes that the last step of a program jumps back to the first step, and in each run through,
+// all other registers are derived from A and A is divided by 8.
+func findValidStarts(ctx context.Context, endRegisters [3]int, program, outputs []int) ([]Computer, error) {
+	tracer := events.FromContext(ctx)
+	computers := []Computer{{Registers: endRegisters, Program: program}}
+	for i := len(outputs) - 1; i >= 0; i-- {
+		newComputers := []Computer{}
+		for _, computer := range computers {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			for offset := 0; offset < 8; offset++ {
+				// A can only be 0 at the start of the first pass, as the program stops once it reaches 0.
+				if computer.Registers[0]*8+offset == 0 && i > 0 {
+					continue
+				}
+				testComputer := Computer{Registers: [3]int{computer.Registers[0]*8 + offset, 0, 0}, Program: program}
+				for step := 0; step < len(testComputer.Program)/2 && !testComputer.halted(); step++ {
+					testComputer.performOperation(tracer)
+				}
+				if len(testComputer.Outputs) == 0 {
+					return nil, errors.New("the program does not output a value in each run through, as part two assumes")
+				}
+				if testComputer.Outputs[0] == outputs[i] {
+					newComputers = append(newComputers, Computer{Registers: [3]int{computer.Registers[0]*8 + offset, 0, 0}, Program: program})
+				}
+			}
+		}
+		computers = newComputers
+	}
+	return computers, nil
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (Computer, error) {
+	scanner := parse.NewScanner(r)
+
+	computer := Computer{Registers: [3]int{}, Program: []int{}}
+	for index, name := range []string{"A", "B", "C"} {
+		line, err := scanner.Next(fmt.Sprintf("a line such as \"Register %s: 729\"", name))
+		if err != nil {
+			return Computer{}, err
+		}
+
+		valueStr, err := line.TrimPrefix(fmt.Sprintf("Register %s: ", name))
+		if err != nil {
+			return Computer{}, err
+		}
+
+		value, err := valueStr.Int()
+		if err != nil {
+			return Computer{}, err
+		}
+		if value < 0 {
+			return Computer{}, valueStr.Invalid("a register value that is not negative")
+		}
+
+		computer.Registers[index] = value
+	}
+
+	if line, err := scanner.Next("an empty line"); err != nil {
+		return Computer{}, err
+	} else if line.Text != "" {
+		return Computer{}, line.Invalid("an empty line")
+	}
+
+	line, err := scanner.Next(`a line such as "Program: 0,1,5,4,3,0"`)
+	if err != nil {
+		return Computer{}, err
+	}
+
+	programStr, err := line.TrimPrefix("Program: ")
+	if err != nil {
+		return Computer{}, err
+	}
+
+	for _, valueStr := range programStr.Split(",") {
+		value, err := valueStr.Int()
+		if err != nil {
+			return Computer{}, err
+		}
+		if value < 0 || value > 7 {
+			return Computer{}, valueStr.Invalid("a 3-bit number from 0 to 7")
+		}
+		computer.Program = append(computer.Program, value)
+	}
+	if len(computer.Program)%2 != 0 {
+		return Computer{}, programStr.Invalid("a program of opcode and operand pairs")
+	}
+
+	return computer, scanner.End()
+}
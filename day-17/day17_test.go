@@ -0,0 +1,72 @@
+package day17
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		registerA, program string
+		want               string
+	}{
+		{"-1", "0,1", `input:1:13: unexpected "-1", expected a register value that is not negative`},
+		{"1", "0,1,5", `input:5:10: unexpected "0,1,5", expected a program of opcode and operand pairs`},
+	}
+	for _, test := range tests {
+		input := "Register A: " + test.registerA + "\nRegister B: 0\nRegister C: 0\n\nProgram: " + test.program
+		if err := New().Parse(strings.NewReader(input)); err == nil || err.Error() != test.want {
+			t.Errorf("%q: got error %v, want %q", input, err, test.want)
+		}
+	}
+}
+
+func TestUnusualPrograms(t *testing.T) {
+	tests := []struct {
+		program string
+		part    int
+		want    string
+	}{
+		// A is shifted right by itself, which is more bits than an int has.
+		{"0,4,5,4", 1, "0"},
+		// The jump lands on the last number of the program, which has no operand, so the computer halts.
+		{"3,3,5,4", 1, ""},
+		{"0,3,3,0", 2, "the program does not output a value in each run through, as part two assumes"},
+	}
+	for _, test := range tests {
+		s := New()
+		input := "Register A: 100\nRegister B: 0\nRegister C: 0\n\nProgram: " + test.program
+		if err := s.Parse(strings.NewReader(input)); err != nil {
+			t.Fatal(err)
+		}
+		answer, err := solver.Part(context.Background(), s, test.part)
+		got := answer.String()
+		if err != nil {
+			got = err.Error()
+		}
+		if got != test.want {
+			t.Errorf("%s part %d: got %q, want %q", test.program, test.part, got, test.want)
+		}
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,71 @@
+package day17
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+)
+
+// maxAttempts is the number of random programs tried before giving up on finding one that can output itself.
+const maxAttempts = 1000
+
+// Generator generates random puzzle inputs for day 17.
+type Generator struct {
+	// OutputLength is the number of values that the program outputs when started with the value in register A.
+	OutputLength int `param:"outputLength"`
+}
+
+// NewGenerator creates a generator of inputs like the real puzzle, whose output is as long as the program.
+func NewGenerator() *Generator {
+	return &Generator{OutputLength: 16}
+}
+
+// Generate writes a random puzzle input. The program has the loop shape that findValidStarts assumes: it takes B
+// from the lowest bits of A, mixes in C = A >> B and random constants, outputs B, divides A by 8 and jumps back to
+// the start. Programs are drawn until one can output a copy of itself, so that part two has an answer.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.OutputLength < 1 || g.OutputLength > 20 {
+		return fmt.Errorf("outputLength must be from 1 to 20, not %d", g.OutputLength)
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		// After C is computed, the loop mixes B with a constant and with C in either order before outputting it,
+		// and divides A by 8 at any point after C is computed.
+		steps := [][]int{{1, rng.IntN(8)}, {4, rng.IntN(8)}}
+		rng.Shuffle(len(steps), func(i, j int) { steps[i], steps[j] = steps[j], steps[i] })
+		steps = append(steps, []int{5, 5})
+		steps = slices.Insert(steps, rng.IntN(len(steps)+1), []int{0, 3})
+		program := []int{2, 4, 1, rng.IntN(8), 7, 5}
+		for _, step := range steps {
+			program = append(program, step...)
+		}
+		program = append(program, 3, 0)
+
+		start, err := smallestStart(context.Background(), program, program)
+		if err != nil {
+			return err
+		}
+		if start == -1 {
+			continue
+		}
+		outputs, err := run(context.Background(), Computer{Registers: [3]int{start, 0, 0}, Program: program})
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(outputs, program) {
+			continue
+		}
+
+		// The program outputs one value for each octal digit of register A.
+		registerA := 1<<(3*(g.OutputLength-1)) + rng.IntN(7<<(3*(g.OutputLength-1)))
+		input := "Register A: " + strconv.Itoa(registerA) + "\nRegister B: 0\nRegister C: 0\n\nProgram: " +
+			constructOutputString(program) + "\n"
+		_, err = io.WriteString(w, input)
+		return err
+	}
+	return errors.New("no program that outputs itself was found")
+}
@@ -0,0 +1,46 @@
+package day17
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+// referenceSmallestStart finds the smallest value of register A for which the program produces the same outputs as
+// the computer does, by trying every value in turn up to the computer's own.
+func referenceSmallestStart(computer Computer) (int, error) {
+	want, err := run(context.Background(), computer)
+	if err != nil {
+		return 0, err
+	}
+	for registerA := 0; ; registerA++ {
+		got, err := run(context.Background(), Computer{Registers: [3]int{registerA, 0, 0}, Program: computer.Program})
+		if err != nil {
+			return 0, err
+		}
+		if slices.Equal(got, want) {
+			return registerA, nil
+		}
+	}
+}
+
+func TestSmallestStartMatchesReference(t *testing.T) {
+	for _, outputLength := range []int{1, 2, 3, 4} {
+		solvertest.Differential(t, &Generator{OutputLength: outputLength}, New,
+			func(s *Solver) (solver.Answer, error) {
+				outputs, err := run(context.Background(), s.computer)
+				if err != nil {
+					return solver.Answer{}, err
+				}
+				start, err := smallestStart(context.Background(), s.computer.Program, outputs)
+				return solver.IntAnswer(start), err
+			},
+			func(s *Solver) (solver.Answer, error) {
+				start, err := referenceSmallestStart(s.computer)
+				return solver.IntAnswer(start), err
+			})
+	}
+}
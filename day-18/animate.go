@@ -0,0 +1,53 @@
+package day18
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// colours are the colours of the cells in the animations.
+var colours = map[byte]animate.Colour{'#': animate.Red, 'O': animate.Green}
+
+// Animate shows the bytes falling along with a shortest route to the exit: the bytes that fall before part one, and
+// for part two every byte up to the one that cuts off the exit.
+func (s *Solver) Animate(part int) (iter.Seq[animate.Frame], error) {
+	switch part {
+	case 1:
+		return fallingFrames(s.bytes[:min(s.SimulatedBytes, len(s.bytes))], s.GridSize), nil
+	case 2:
+		return fallingFrames(s.bytes, s.GridSize), nil
+	}
+	return nil, animate.ErrNoAnimation
+}
+
+// fallingFrames yields a frame as each byte falls, until they have all fallen or there is no longer a route to the
+// exit.
+func fallingFrames(bytes [][2]int, gridSize int) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		memory := grid.New(gridSize+1, gridSize+1, byte('.'))
+		route := findRoute(memory)
+		for i, byte := range bytes {
+			memory.Set(position(byte), '#')
+			if route[position(byte)] {
+				route = findRoute(memory)
+			}
+
+			frame := animate.Frame{Grid: memory.Clone(), Colours: colours}
+			caption := fmt.Sprintf("%d bytes fallen, the last at %d,%d: ", i+1, byte[0], byte[1])
+			if route == nil {
+				frame.Caption = caption + "no route to the exit"
+			} else {
+				frame.Caption = caption + fmt.Sprintf("shortest route %d steps", len(route)-1)
+			}
+			for position := range route {
+				frame.Grid.Set(position, 'O')
+			}
+			if !yield(frame) || route == nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package day18
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Blockage is the witness for part two of day 18: the first byte that cuts off the exit, along with a route to the
+// exit that was still open before it fell.
+type Blockage struct {
+	Index  int    // index of the byte in the input
+	Byte   [2]int // X and Y coordinates of the byte
+	Route  []grid.Point
+	Memory *grid.Grid[byte] // the memory space just before the byte fell
+}
+
+// String describes the byte and draws the route on the memory space, with the byte marked '@'.
+func (b Blockage) String() string {
+	memory := b.Memory.Clone()
+	for _, position := range b.Route {
+		memory.Set(position, 'O')
+	}
+	memory.Set(position(b.Byte), '@')
+	return fmt.Sprintf("byte %d at %d,%d cuts off the exit, which was reachable in %d steps before it fell\n%s",
+		b.Index+1, b.Byte[0], b.Byte[1], len(b.Route)-1, strings.TrimSuffix(memory.String(), "\n"))
+}
+
+// Certify solves a part of the puzzle along with the blockage for part two.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	switch part {
+	case 1:
+		return solver.Answer{}, nil, solver.ErrNoWitness
+	case 2:
+	default:
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	memory := grid.New(s.GridSize+1, s.GridSize+1, byte('.'))
+	route := findPath(memory)
+	for i, byte := range s.bytes {
+		if err := ctx.Err(); err != nil {
+			return solver.Answer{}, nil, err
+		}
+		if !slices.Contains(route, position(byte)) {
+			memory.Set(position(byte), '#')
+			continue
+		}
+		before := memory.Clone()
+		memory.Set(position(byte), '#')
+		if newRoute := findPath(memory); newRoute != nil {
+			route = newRoute
+			continue
+		}
+		answer := solver.StringAnswer(strconv.Itoa(byte[0]) + "," + strconv.Itoa(byte[1]))
+		return answer, Blockage{Index: i, Byte: byte, Route: route, Memory: before}, nil
+	}
+	return solver.Answer{}, nil, errors.New("the exit is never cut off")
+}
+
+// Verify checks that the byte in the witness is the answer, that the route leads from the top left to the bottom
+// right around the bytes that fell before it, and that once it has fallen nothing can reach the bottom right from the
+// top left.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	switch part {
+	case 1:
+		return solver.ErrNoWitness
+	case 2:
+	default:
+		return solver.ErrNoPart
+	}
+	blockage, ok := witness.(Blockage)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not a day 18 blockage", witness)
+	}
+	if blockage.Index < 0 || blockage.Index >= len(s.bytes) || s.bytes[blockage.Index] != blockage.Byte {
+		return fmt.Errorf("byte %d at %d,%d is not in the input", blockage.Index+1, blockage.Byte[0], blockage.Byte[1])
+	}
+	want := fmt.Sprintf("%d,%d", blockage.Byte[0], blockage.Byte[1])
+	if answer.Kind() != solver.String || answer.String() != want {
+		return fmt.Errorf("the answer is %s, not the byte at %s", answer, want)
+	}
+
+	corrupted := map[[2]int]bool{}
+	for _, byte := range s.bytes[:blockage.Index] {
+		corrupted[byte] = true
+	}
+	inside := func(x, y int) bool {
+		return x >= 0 && y >= 0 && x <= s.GridSize && y <= s.GridSize
+	}
+	if len(blockage.Route) == 0 || blockage.Route[0] != (grid.Point{}) ||
+		blockage.Route[len(blockage.Route)-1] != (grid.Point{Row: s.GridSize, Col: s.GridSize}) {
+		return errors.New("the route does not lead from the top left to the bottom right")
+	}
+	for i, step := range blockage.Route {
+		if x, y := step.Col, step.Row; !inside(x, y) || corrupted[[2]int{x, y}] {
+			return fmt.Errorf("the route goes through %d,%d, which is corrupted or outside the memory space", x, y)
+		}
+		if i > 0 && step.Manhattan(blockage.Route[i-1]) != 1 {
+			return fmt.Errorf("the route jumps to %d,%d", step.Col, step.Row)
+		}
+	}
+
+	// Fill the memory space from the top left once the byte has fallen.
+	corrupted[blockage.Byte] = true
+	reached := map[[2]int]bool{{0, 0}: true}
+	stack := [][2]int{{0, 0}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, offset := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			next := [2]int{current[0] + offset[0], current[1] + offset[1]}
+			if inside(next[0], next[1]) && !corrupted[next] && !reached[next] {
+				reached[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	if reached[[2]int{s.GridSize, s.GridSize}] {
+		return fmt.Errorf("the exit can still be reached after byte %d has fallen", blockage.Index+1)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package day18
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 18.
+type Solver struct {
+	// GridSize is the largest coordinate in the memory space.
+	GridSize int `param:"gridSize"`
+	// SimulatedBytes is the number of bytes that have fallen in part one.
+	SimulatedBytes int `param:"simulatedBytes"`
+
+	bytes [][2]int
+}
+
+// New creates a solver for the puzzle for day 18.
+func New() *Solver {
+	return &Solver{GridSize: 70, SimulatedBytes: 1024}
+}
+
+// maxGridSize is the largest grid size, which keeps the memory space to a million positions.
+const maxGridSize = 999
+
+// Validate checks that the largest coordinate of the memory space is from 1 to maxGridSize, and that the number of
+// bytes to simulate is no more than could fall on the largest space.
+func (s *Solver) Validate() error {
+	if err := solver.CheckRange("gridSize", s.GridSize, 1, maxGridSize); err != nil {
+		return err
+	}
+	return solver.CheckRange("simulatedBytes", s.SimulatedBytes, 0, (maxGridSize+1)*(maxGridSize+1))
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input, which must only have bytes within the memory space given by GridSize.
+func (s *Solver) Parse(r io.Reader) error {
+	bytes, err := readLines(r, s.GridSize)
+	if err != nil {
+		return err
+	}
+
+	s.bytes = bytes
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.bytes, s.GridSize, s.SimulatedBytes)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.bytes, s.GridSize)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.StringAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, bytes [][2]int, gridSize int, simulatedBytes int) (int, error) {
+	if len(bytes) < simulatedBytes {
+		return 0, fmt.Errorf("only %d bytes fall, fewer than the %d to simulate", len(bytes), simulatedBytes)
+	}
+	memory := grid.New(gridSize+1, gridSize+1, byte('.'))
+	for i := 0; i < simulatedBytes; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		memory.Set(position(bytes[i]), '#')
+	}
+	shortestRouteLength, err := findShortestRouteLength(ctx, memory)
+	if err != nil {
+		return 0, err
+	}
+	if shortestRouteLength == nil {
+		return 0, fmt.Errorf("no route to the exit after %d bytes have fallen", simulatedBytes)
+	}
+	return *shortestRouteLength, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, bytes [][2]int, gridSize int) (string, error) {
+	memory := grid.New(gridSize+1, gridSize+1, byte('.'))
+	for _, byte := range bytes {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		memory.Set(position(byte), '#')
+		shortestRouteLength, err := findShortestRouteLength(ctx, memory)
+		if err != nil {
+			return "", err
+		}
+		if shortestRouteLength == nil {
+			return strconv.Itoa(byte[0]) + "," + strconv.Itoa(byte[1]), nil
+		}
+	}
+	return "", errors.New("the exit is never cut off")
+}
+
+// position converts the X and Y coordinates of a byte to a position in the memory space.
+func position(byte [2]int) grid.Point {
+	return grid.Point{Row: byte[1], Col: byte[0]}
+}
+
+// findShortestRouteLength finds the length of the shortest route from the top left to the bottom right.
+func findShortestRouteLength(ctx context.Context, memory *grid.Grid[byte]) (*int, error) {
+	distance := 0
+	start, end := grid.Point{Row: 0, Col: 0}, grid.Point{Row: memory.Height() - 1, Col: memory.Width() - 1}
+	allPositions := map[grid.Point]bool{start: true}
+	positionsToConsider := map[grid.Point]bool{start: true}
+	for len(positionsToConsider) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		distance++
+		newPositions := map[grid.Point]bool{}
+		for positionToConsider := range positionsToConsider {
+			for adjacentPosition := range memory.Neighbours4(positionToConsider) {
+				if !allPositions[adjacentPosition] && memory.At(adjacentPosition) == '.' {
+					if adjacentPosition == end {
+						return &distance, nil
+					}
+					newPositions[adjacentPosition] = true
+					allPositions[adjacentPosition] = true
+				}
+			}
+		}
+		positionsToConsider = newPositions
+	}
+	return nil, nil
+}
+
+// readLines converts the information from the input into a usable form, checking that each byte falls within a
+// memory space whose largest coordinate is gridSize.
+func readLines(r io.Reader, gridSize int) ([][2]int, error) {
+	scanner := parse.NewScanner(r)
+
+	bytes := [][2]int{}
+	for scanner.Scan() {
+		splitValues, err := scanner.Line().SplitN(",", 2, `a position such as "5,4"`)
+		if err != nil {
+			return nil, err
+		}
+
+		first, err := splitValues[0].Int()
+		if err != nil {
+			return nil, err
+		}
+		if first < 0 || first > gridSize {
+			return nil, splitValues[0].Invalid(fmt.Sprintf("an X coordinate from 0 to %d", gridSize))
+		}
+
+		second, err := splitValues[1].Int()
+		if err != nil {
+			return nil, err
+		}
+		if second < 0 || second > gridSize {
+			return nil, splitValues[1].Invalid(fmt.Sprintf("a Y coordinate from 0 to %d", gridSize))
+		}
+
+		bytes = append(bytes, [2]int{first, second})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
+}
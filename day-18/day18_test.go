@@ -0,0 +1,110 @@
+package day18
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestInvalidInput(t *testing.T) {
+	params := map[string]int{"gridSize": 6, "simulatedBytes": 3}
+	for input, want := range map[string]string{
+		"1,2\n7,0\n":  `input:2:1: unexpected "7", expected an X coordinate from 0 to 6`,
+		"1,2\n0,-1\n": `input:2:3: unexpected "-1", expected a Y coordinate from 0 to 6`,
+	} {
+		s := New()
+		if err := solver.SetParams(s, params); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Parse(strings.NewReader(input)); err == nil || err.Error() != want {
+			t.Errorf("%q: got error %v, want %q", input, err, want)
+		}
+	}
+
+	for part, want := range map[int]string{
+		1: "only 2 bytes fall, fewer than the 3 to simulate",
+		2: "the exit is never cut off",
+	} {
+		s := New()
+		if err := solver.SetParams(s, params); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Parse(strings.NewReader("1,0\n1,1\n")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := solver.Part(context.Background(), s, part); err == nil || err.Error() != want {
+			t.Errorf("part %d: got error %v, want %q", part, err, want)
+		}
+	}
+}
+
+func TestCancelled(t *testing.T) {
+	s := New()
+	if err := solvertest.Parse(s, "input.txt", nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for part := 1; part <= 2; part++ {
+		if _, err := solver.Part(ctx, s, part); !errors.Is(err, context.Canceled) {
+			t.Errorf("part %d: got error %v, want %v", part, err, context.Canceled)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestAnimate(t *testing.T) {
+	params := map[string]int{"gridSize": 6, "simulatedBytes": 12}
+	for part, want := range map[int]string{1: "12 bytes fallen, the last at 5,1: shortest route 22 steps",
+		2: "21 bytes fallen, the last at 6,1: no route to the exit"} {
+		frames := solvertest.Frames(t, New, "test_data.txt", params, part)
+		if last := frames[len(frames)-1].Caption; last != want {
+			t.Errorf("part %d: last frame has caption %q, want %q", part, last, want)
+		}
+	}
+}
+
+func TestCertify(t *testing.T) {
+	params := map[string]int{"gridSize": 6, "simulatedBytes": 12}
+	answer, witness := solvertest.Certified(t, New, "test_data.txt", params, 2)
+
+	s := New()
+	if err := solvertest.Parse(s, "test_data.txt", params); err != nil {
+		t.Fatal(err)
+	}
+	blockage := witness.(Blockage)
+	earlier := blockage
+	earlier.Index--
+	earlier.Byte = s.bytes[earlier.Index]
+	earlierAnswer := solver.StringAnswer(fmt.Sprintf("%d,%d", earlier.Byte[0], earlier.Byte[1]))
+	if err := s.Verify(2, earlierAnswer, earlier); err == nil {
+		t.Error("verified a byte that does not cut off the exit")
+	}
+	blocked := blockage
+	blocked.Route = slices.Clone(blockage.Route)
+	blocked.Route[1], blocked.Route[2] = blocked.Route[2], blocked.Route[1]
+	if err := s.Verify(2, answer, blocked); err == nil {
+		t.Error("verified a route that jumps")
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
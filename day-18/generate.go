@@ -0,0 +1,118 @@
+package day18
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 18.
+type Generator struct {
+	// GridSize is the largest coordinate of the memory space, as for the solver.
+	GridSize int `param:"gridSize"`
+	// Bytes is the number of falling bytes.
+	Bytes int `param:"bytes"`
+	// OpenBytes is the number of bytes that fall before the exit may be cut off, as for the solver's simulatedBytes.
+	OpenBytes int `param:"openBytes"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{GridSize: 70, Bytes: 3450, OpenBytes: 1024}
+}
+
+// Generate writes a random puzzle input. Bytes fall on distinct positions, never on the start or the exit, and the
+// exit can still be reached once the first openBytes have fallen.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.GridSize < 1 {
+		return fmt.Errorf("gridSize must be at least 1, not %d", g.GridSize)
+	}
+	positions := (g.GridSize + 1) * (g.GridSize + 1)
+	if g.Bytes < 0 || g.Bytes > positions-2 {
+		return fmt.Errorf("bytes must be from 0 to %d, not %d", positions-2, g.Bytes)
+	}
+	if g.OpenBytes < 0 {
+		return fmt.Errorf("openBytes must not be negative, not %d", g.OpenBytes)
+	}
+
+	// The start and exit are the first and last positions, so only those between them are shuffled. Until enough
+	// bytes have fallen, any byte that would cut off the exit is put back to fall later. A byte can only do that
+	// if it lands on the route being kept open, so a new route is only searched for then.
+	queue := []grid.Point{}
+	for _, index := range rng.Perm(positions - 2) {
+		queue = append(queue, grid.Point{Row: (index + 1) / (g.GridSize + 1), Col: (index + 1) % (g.GridSize + 1)})
+	}
+	memory := grid.New(g.GridSize+1, g.GridSize+1, byte('.'))
+	route := findRoute(memory)
+	var builder strings.Builder
+	for fallen := 0; fallen < g.Bytes; fallen++ {
+		next := queue[0]
+		queue = queue[1:]
+		for putBack := 0; fallen < g.OpenBytes; putBack++ {
+			memory.Set(next, '#')
+			if !route[next] {
+				break
+			}
+			if newRoute := findRoute(memory); newRoute != nil {
+				route = newRoute
+				break
+			}
+			memory.Set(next, '.')
+			if putBack == len(queue) {
+				return fmt.Errorf("only %d bytes can fall without cutting off the exit, not openBytes %d", fallen,
+					g.OpenBytes)
+			}
+			queue = append(queue, next)
+			next = queue[0]
+			queue = queue[1:]
+		}
+		fmt.Fprintf(&builder, "%d,%d\n", next.Col, next.Row)
+	}
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+// findRoute finds the positions on a shortest route from the top left to the bottom right, or nil if there is none.
+func findRoute(memory *grid.Grid[byte]) map[grid.Point]bool {
+	path := findPath(memory)
+	if path == nil {
+		return nil
+	}
+	route := map[grid.Point]bool{}
+	for _, position := range path {
+		route[position] = true
+	}
+	return route
+}
+
+// findPath finds a shortest route from the top left to the bottom right as the positions along it in order, or nil if
+// there is none.
+func findPath(memory *grid.Grid[byte]) []grid.Point {
+	start, end := grid.Point{Row: 0, Col: 0}, grid.Point{Row: memory.Height() - 1, Col: memory.Width() - 1}
+	previous := map[grid.Point]grid.Point{start: start}
+	queue := []grid.Point{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == end {
+			path := []grid.Point{current}
+			for current != start {
+				current = previous[current]
+				path = append(path, current)
+			}
+			slices.Reverse(path)
+			return path
+		}
+		for adjacent := range memory.Neighbours4(current) {
+			if _, ok := previous[adjacent]; !ok && memory.At(adjacent) == '.' {
+				previous[adjacent] = current
+				queue = append(queue, adjacent)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package day19
+
+import (
+	"context"
+	"math/big"
+)
+
+// SetBigInt switches the solver to use big integers in part two, so that long designs can be made in more ways than
+// an int can count.
+func (s *Solver) SetBigInt(enabled bool) {
+	s.bigInt = enabled
+}
+
+// bigPartTwo solves part two of the puzzle with big integers.
+func bigPartTwo(ctx context.Context, patterns, designs []string) (*big.Int, error) {
+	total := new(big.Int)
+	for _, design := range designs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		total.Add(total, countBigArrangements(design, patterns))
+	}
+	return total, nil
+}
+
+// countBigArrangements counts all the ways the design can be made with the patterns.
+func countBigArrangements(design string, patterns []string) *big.Int {
+	remainders := map[string]*big.Int{design: big.NewInt(1)}
+	total := new(big.Int)
+	for len(remainders) > 0 {
+		newRemainders := map[string]*big.Int{}
+		for remainder, count := range remainders {
+			for _, pattern := range patterns {
+				if newRemainder := getRemainder(remainder, pattern); newRemainder != nil {
+					if *newRemainder == "" {
+						total.Add(total, count)
+					} else {
+						if newRemainders[*newRemainder] == nil {
+							newRemainders[*newRemainder] = new(big.Int)
+						}
+						newRemainders[*newRemainder].Add(newRemainders[*newRemainder], count)
+					}
+				}
+			}
+		}
+		remainders = newRemainders
+	}
+	return total
+}
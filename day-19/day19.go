@@ -0,0 +1,203 @@
+package day19
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// colours are the colours of stripe that patterns and designs are made of.
+const colours = "wubrg"
+
+// Solver solves the puzzle for day 19.
+type Solver struct {
+	patterns []string
+	designs  []string
+	bigInt   bool
+}
+
+// New creates a solver for the puzzle for day 19.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	patterns, designs, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.patterns, s.designs = patterns, designs
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.patterns, s.designs)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := bigPartTwo(ctx, s.patterns, s.designs)
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partTwo(ctx, s.patterns, s.designs)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, patterns, designs []string) (int, error) {
+	total := 0
+	for _, design := range designs {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if designPossible(design, patterns) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, patterns, designs []string) (int, error) {
+	total := 0
+	for _, design := range designs {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		arrangements, err := countArrangements(design, patterns)
+		if err != nil {
+			return 0, err
+		}
+		if total, err = checked.Add(total, arrangements); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// designPossible checks whether a design can be made with the given patterns.
+func designPossible(design string, patterns []string) bool {
+	remainders := map[string]bool{design: true}
+	for len(remainders) > 0 {
+		newRemainders := map[string]bool{}
+		for remainder := range remainders {
+			for _, pattern := range patterns {
+				if newRemainder := getRemainder(remainder, pattern); newRemainder != nil {
+					if *newRemainder == "" {
+						return true
+					} else {
+						newRemainders[*newRemainder] = true
+					}
+				}
+			}
+		}
+		remainders = newRemainders
+	}
+	return false
+}
+
+// getRemainder returns the remainder of the design that appears after the pattern, or nil if the pattern
+// does not appear at the start of the design.
+func getRemainder(design, pattern string) *string {
+	if len(design) < len(pattern) {
+		return nil
+	}
+	for i := 0; i < len(pattern); i++ {
+		if design[i] != pattern[i] {
+			return nil
+		}
+	}
+	remainder := design[len(pattern):]
+	return &remainder
+}
+
+// countArrangements counts all the ways the design can be made with the patterns.
+func countArrangements(design string, patterns []string) (int, error) {
+	remainders := map[string]int{design: 1}
+	total := 0
+	for len(remainders) > 0 {
+		newRemainders := map[string]int{}
+		for remainder, count := range remainders {
+			for _, pattern := range patterns {
+				if newRemainder := getRemainder(remainder, pattern); newRemainder != nil {
+					var err error
+					if *newRemainder == "" {
+						total, err = checked.Add(total, count)
+					} else {
+						newRemainders[*newRemainder], err = checked.Add(newRemainders[*newRemainder], count)
+					}
+					if err != nil {
+						return 0, err
+					}
+				}
+			}
+		}
+		remainders = newRemainders
+	}
+	return total, nil
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]string, []string, error) {
+	scanner := parse.NewScanner(r)
+
+	line, err := scanner.Next("towel patterns separated by commas")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	patterns := []string{}
+	for _, pattern := range line.Split(", ") {
+		if pattern.Text == "" {
+			return nil, nil, pattern.Invalid("a towel pattern")
+		}
+		if err := pattern.OneOf(colours); err != nil {
+			return nil, nil, err
+		}
+		patterns = append(patterns, pattern.Text)
+	}
+
+	if line, err := scanner.Next("an empty line"); err != nil {
+		return nil, nil, err
+	} else if line.Text != "" {
+		return nil, nil, line.Invalid("an empty line")
+	}
+
+	designs := []string{}
+	for scanner.Scan() {
+		if err := scanner.Line().OneOf(colours); err != nil {
+			return nil, nil, err
+		}
+		designs = append(designs, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return patterns, designs, nil
+}
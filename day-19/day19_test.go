@@ -0,0 +1,53 @@
+package day19
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGoldenBigInt(t *testing.T) {
+	solvertest.GoldenBigInt(t, New)
+}
+
+func TestOverflow(t *testing.T) {
+	// Each stripe can be one of two patterns, so there are 2^70 arrangements.
+	input := "w, w\n\n" + strings.Repeat("w", 70) + "\n"
+	s := New()
+	if err := s.Parse(strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PartTwo(context.Background()); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("got error %v, want %v", err, checked.ErrOverflow)
+	}
+
+	s.SetBigInt(true)
+	answer, err := s.PartTwo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "1180591620717411303424"; answer.Kind() != solver.Big || answer.String() != want {
+		t.Errorf("got %s answer %s, want big answer %s", answer.Kind(), answer, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,89 @@
+package day19
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 19.
+type Generator struct {
+	// Patterns is the number of towel patterns.
+	Patterns int `param:"patterns"`
+	// Designs is the number of designs.
+	Designs int `param:"designs"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Patterns: 447, Designs: 400}
+}
+
+// patternLengths weights the lengths of the patterns, from 1 stripe up, by how many of each length the real puzzle
+// has. Short patterns are rare, as with many of them the number of ways to make a long design outgrows an int.
+var patternLengths = []int{4, 23, 120, 100, 80, 60, 40, 20}
+
+// Generate writes a random puzzle input. As in the real puzzle, one colour never appears as a pattern on its own and
+// there are few short patterns, so designs have a large but not enormous number of arrangements. Half of the designs
+// are made by joining patterns, so that they are possible, and the rest are random colours, which are rarely
+// possible.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Patterns < 1 || g.Patterns > 10000 {
+		return fmt.Errorf("patterns must be from 1 to 10000, not %d", g.Patterns)
+	}
+	if g.Designs < 0 {
+		return fmt.Errorf("designs must not be negative, not %d", g.Designs)
+	}
+
+	randomStripes := func(length int) string {
+		stripes := make([]byte, length)
+		for i := range stripes {
+			stripes[i] = colours[rng.IntN(len(colours))]
+		}
+		return string(stripes)
+	}
+
+	totalWeight := 0
+	for _, weight := range patternLengths {
+		totalWeight += weight
+	}
+	randomLength := func() int {
+		n := rng.IntN(totalWeight)
+		for i, weight := range patternLengths {
+			if n < weight {
+				return i + 1
+			}
+			n -= weight
+		}
+		return len(patternLengths)
+	}
+
+	alone := string(colours[rng.IntN(len(colours))])
+	seen := map[string]bool{alone: true}
+	patterns := []string{}
+	for len(patterns) < g.Patterns {
+		pattern := randomStripes(randomLength())
+		if !seen[pattern] {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(strings.Join(patterns, ", ") + "\n\n")
+	for i := 0; i < g.Designs; i++ {
+		length := 40 + rng.IntN(21)
+		if i%2 == 1 {
+			builder.WriteString(randomStripes(length) + "\n")
+			continue
+		}
+		design := ""
+		for len(design) < length {
+			design += patterns[rng.IntN(len(patterns))]
+		}
+		builder.WriteString(design + "\n")
+	}
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
@@ -0,0 +1,67 @@
+package day20
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// colours are the colours of the cells in the animations.
+var colours = map[byte]animate.Colour{'#': animate.Grey, 'S': animate.Red, 'E': animate.Red, 'o': animate.Blue,
+	'@': animate.Yellow}
+
+// Animate follows the route around the racetrack, showing where the cheats that save enough time can be made from
+// each position: cheats of up to 2 picoseconds for part one, and of up to the cheat radius for part two.
+func (s *Solver) Animate(part int) (iter.Seq[animate.Frame], error) {
+	if part != 1 && part != 2 {
+		return nil, animate.ErrNoAnimation
+	}
+	cheatRadius := s.CheatRadius
+	if part == 1 {
+		cheatRadius = 2
+	}
+	route, err := constructRoute(context.Background(), s.racetrack)
+	if err != nil {
+		return nil, err
+	}
+	return cheatFrames(s.racetrack, route, s.PicosecondsToSave, cheatRadius), nil
+}
+
+// cheatFrames yields a frame for each position along the route, highlighting the ends of the cheats from there that
+// save at least picosecondsToSave.
+func cheatFrames(racetrack *grid.Grid[byte], route map[grid.Point]int, picosecondsToSave, cheatRadius int) iter.Seq[animate.Frame] {
+	return func(yield func(animate.Frame) bool) {
+		positions := make([]grid.Point, len(route))
+		for position, picoseconds := range route {
+			positions[picoseconds] = position
+		}
+
+		picture := racetrack.Clone()
+		total := 0
+		for picoseconds, position := range positions {
+			frame := animate.Frame{Grid: picture.Clone(), Colours: colours, Highlights: map[grid.Point]animate.Colour{}}
+			frame.Grid.Set(position, '@')
+			for row := -cheatRadius; row <= cheatRadius; row++ {
+				for col := -cheatRadius; col <= cheatRadius; col++ {
+					cheatEnd := position.Add(grid.Point{Row: row, Col: col})
+					length := position.Manhattan(cheatEnd)
+					if end, ok := route[cheatEnd]; ok && length <= cheatRadius && end-picoseconds-length >= picosecondsToSave {
+						frame.Highlights[cheatEnd] = animate.Green
+					}
+				}
+			}
+			total += len(frame.Highlights)
+			frame.Caption = fmt.Sprintf("picosecond %d: %d cheats from here, %d in all", picoseconds,
+				len(frame.Highlights), total)
+			if !yield(frame) {
+				return
+			}
+			if racetrack.At(position) == '.' {
+				picture.Set(position, 'o')
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/markcooper37/aoc-2024/grid"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 20.
+type Solver struct {
+	// PicosecondsToSave is the minimum saving for a cheat to be counted.
+	PicosecondsToSave int `param:"picosecondsToSave"`
+	// CheatRadius is the longest a cheat can last in part two.
+	CheatRadius int `param:"cheatRadius"`
+
+	racetrack *grid.Grid[byte]
+}
+
+// New creates a solver for the puzzle for day 20.
+func New() *Solver {
+	return &Solver{PicosecondsToSave: 100, CheatRadius: 20}
+}
+
+// maxCheatRadius is the longest a cheat can last, which is more than enough to cross any racetrack that fits in an
+// input that the server accepts.
+const maxCheatRadius = 1000
+
+// Validate checks that cheats must save at least a picosecond and can last from 1 to maxCheatRadius picoseconds.
+func (s *Solver) Validate() error {
+	if err := solver.CheckRange("picosecondsToSave", s.PicosecondsToSave, 1, math.MaxInt); err != nil {
+		return err
+	}
+	return solver.CheckRange("cheatRadius", s.CheatRadius, 1, maxCheatRadius)
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	racetrack, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.racetrack = racetrack
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.racetrack, s.PicosecondsToSave)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.racetrack, s.PicosecondsToSave, s.CheatRadius)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, racetrack *grid.Grid[byte], picosecondsToSave int) (int, error) {
+	route, err := constructRoute(ctx, racetrack)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for position, picoseconds := range route {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for adjacentPosition := range position.Neighbours4() {
+			if racetrack.At(adjacentPosition) == '#' {
+				for adjacentPositionToWall := range racetrack.Neighbours4(adjacentPosition) {
+					if adjacentPositionToWall != position && racetrack.At(adjacentPositionToWall) != '#' {
+						timeSaved := route[adjacentPositionToWall] - picoseconds - 2
+						if timeSaved >= picosecondsToSave {
+							total++
+						}
+					}
+				}
+			}
+		}
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, racetrack *grid.Grid[byte], picosecondsToSave, cheatRadius int) (int, error) {
+	route, err := constructRoute(ctx, racetrack)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for position, picoseconds := range route {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		cheatEnds := map[grid.Point]bool{}
+		positionsToConsider := map[grid.Point]bool{position: true}
+		for i := 1; i <= cheatRadius; i++ {
+			allAdjacentPositions := map[grid.Point]bool{}
+			for position := range positionsToConsider {
+				for adjacentPosition := range racetrack.Neighbours4(position) {
+					allAdjacentPositions[adjacentPosition] = true
+				}
+			}
+			newPositionsToConsider := map[grid.Point]bool{}
+			for adjacentPosition := range allAdjacentPositions {
+				newPositionsToConsider[adjacentPosition] = true
+				if !cheatEnds[adjacentPosition] {
+					cheatEnds[adjacentPosition] = true
+					timeSaved := route[adjacentPosition] - picoseconds - i
+					if timeSaved >= picosecondsToSave {
+						total++
+					}
+				}
+			}
+			positionsToConsider = newPositionsToConsider
+		}
+	}
+	return total, nil
+}
+
+// constructRoute constructs a route from the start to the end, returning an error if the track does not lead from
+// one to the other.
+func constructRoute(ctx context.Context, racetrack *grid.Grid[byte]) (map[grid.Point]int, error) {
+	start, _ := racetrack.Find('S')
+	route := map[grid.Point]int{start: 0}
+	newPosition := start
+	end, _ := racetrack.Find('E')
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		previousPosition := newPosition
+		for adjacentPosition := range newPosition.Neighbours4() {
+			if _, ok := route[adjacentPosition]; !ok &&
+				(racetrack.At(adjacentPosition) == '.' || racetrack.At(adjacentPosition) == 'E') {
+				route[adjacentPosition] = route[newPosition] + 1
+				if adjacentPosition == end {
+					return route, nil
+				}
+				newPosition = adjacentPosition
+				break
+			}
+		}
+		if newPosition == previousPosition {
+			return nil, errors.New("the racetrack does not lead from S to E")
+		}
+	}
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (*grid.Grid[byte], error) {
+	scanner := parse.NewScanner(r)
+	racetrack, err := parse.WalledGrid(scanner, "#.SE", '#')
+	if err != nil {
+		return nil, err
+	}
+	if err := scanner.End(); err != nil {
+		return nil, err
+	}
+
+	if _, ok := racetrack.Find('S'); !ok {
+		return nil, errors.New("racetrack has no start")
+	}
+	if _, ok := racetrack.Find('E'); !ok {
+		return nil, errors.New("racetrack has no end")
+	}
+	return racetrack, nil
+}
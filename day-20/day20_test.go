@@ -0,0 +1,52 @@
+package day20
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestUnwalledRacetrack(t *testing.T) {
+	want := `input:1:1: unexpected "S", expected '#' all around the edge of the grid`
+	if err := New().Parse(strings.NewReader("S.E\n")); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	params := map[string]int{"picosecondsToSave": 50}
+	for part, want := range map[int]string{1: "1 in all", 2: "285 in all"} {
+		frames := solvertest.Frames(t, New, "test_data.txt", params, part)
+		if last := frames[len(frames)-1].Caption; !strings.HasSuffix(last, want) {
+			t.Errorf("part %d: last frame has caption %q, want it to end with %q", part, last, want)
+		}
+	}
+}
+
+func TestNoRoute(t *testing.T) {
+	s := New()
+	if err := s.Parse(strings.NewReader("#####\n#S#E#\n#####\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PartOne(context.Background()); err == nil {
+		t.Error("expected an error for a racetrack with no route from S to E")
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,59 @@
+package day20
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/gen"
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Generator generates random puzzle inputs for day 20.
+type Generator struct {
+	// Size is the width and height of the racetrack, which must be odd.
+	Size int `param:"size"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Size: 141}
+}
+
+// Generate writes a random puzzle input. The racetrack is the path between two random points of a random maze, so
+// it is a single corridor as the puzzle promises.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Size < 5 || g.Size%2 == 0 {
+		return fmt.Errorf("size must be odd and at least 5, not %d", g.Size)
+	}
+
+	maze := gen.Maze(g.Size, g.Size, rng)
+	start := gen.OddCell(g.Size, g.Size, rng)
+	end := gen.OddCell(g.Size, g.Size, rng)
+	for end == start {
+		end = gen.OddCell(g.Size, g.Size, rng)
+	}
+
+	// A breadth-first search from the end leaves each position pointing at the next step of its path to the end.
+	next := map[grid.Point]grid.Point{end: end}
+	queue := []grid.Point{end}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for adjacent := range maze.Neighbours4(current) {
+			if _, ok := next[adjacent]; !ok && maze.At(adjacent) == '.' {
+				next[adjacent] = current
+				queue = append(queue, adjacent)
+			}
+		}
+	}
+
+	racetrack := grid.New[byte](g.Size, g.Size, '#')
+	for position := start; position != end; position = next[position] {
+		racetrack.Set(position, '.')
+	}
+	racetrack.Set(start, 'S')
+	racetrack.Set(end, 'E')
+	_, err := racetrack.WriteTo(w)
+	return err
+}
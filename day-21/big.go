@@ -0,0 +1,69 @@
+package day21
+
+import (
+	"context"
+	"math/big"
+)
+
+// SetBigInt switches the solver to use big integers in part two, so that the sequences can be longer than an int
+// can count when there are more robots.
+func (s *Solver) SetBigInt(enabled bool) {
+	s.bigInt = enabled
+}
+
+// bigComplexity adds up the complexities of the codes with big integers.
+func bigComplexity(ctx context.Context, codes [][]string, directionalRobots int) (*big.Int, error) {
+	total := new(big.Int)
+	for _, code := range codes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		length := new(big.Int)
+		current := "A"
+		for _, next := range code {
+			length.Add(length, findBigMinimumDirectionPresses(current, next, directionalRobots))
+			current = next
+		}
+		total.Add(total, length.Mul(length, big.NewInt(int64(numericalPart(code)))))
+	}
+	return total, nil
+}
+
+// findBigMinimumDirectionPresses finds the fewest presses required to input the next digit, counting the pairs of
+// buttons pressed in a row with big integers.
+func findBigMinimumDirectionPresses(current, next string, directionalRobots int) *big.Int {
+	var minPresses *big.Int
+	for _, sequence := range numericalToDirectional(current, next) {
+		pairs := map[[2]string]*big.Int{}
+		add := func(pairs map[[2]string]*big.Int, pair [2]string, count *big.Int) {
+			if pairs[pair] == nil {
+				pairs[pair] = new(big.Int)
+			}
+			pairs[pair].Add(pairs[pair], count)
+		}
+		current := "A"
+		for _, next := range sequence {
+			add(pairs, [2]string{current, next}, big.NewInt(1))
+			current = next
+		}
+		for i := 0; i < directionalRobots; i++ {
+			newPairs := map[[2]string]*big.Int{}
+			for pair, count := range pairs {
+				current = "A"
+				for _, next := range directionalToDirectional(pair[0], pair[1]) {
+					add(newPairs, [2]string{current, next}, count)
+					current = next
+				}
+			}
+			pairs = newPairs
+		}
+		pressCount := new(big.Int)
+		for _, count := range pairs {
+			pressCount.Add(pressCount, count)
+		}
+		if minPresses == nil || pressCount.Cmp(minPresses) < 0 {
+			minPresses = pressCount
+		}
+	}
+	return minPresses
+}
@@ -0,0 +1,174 @@
+package day21
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// KeyPresses is the witness for part one of day 21: the buttons to press on the directional keypad to type each code.
+type KeyPresses []CodePresses
+
+// CodePresses gives the buttons pressed on the directional keypad in front of the first robot to type a code.
+type CodePresses struct {
+	Code    string
+	Presses string
+}
+
+// String shows the presses for each code, along with its complexity.
+func (k KeyPresses) String() string {
+	lines := []string{}
+	for _, code := range k {
+		lines = append(lines, fmt.Sprintf("%s: %s (%d presses)", code.Code, code.Presses, len(code.Presses)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Certify solves a part of the puzzle along with the presses for each code for part one. The sequences for part two
+// are far too long to write out.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	switch part {
+	case 1:
+	case 2:
+		return solver.Answer{}, nil, solver.ErrNoWitness
+	default:
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	total := 0
+	witness := KeyPresses{}
+	for _, code := range s.codes {
+		if err := ctx.Err(); err != nil {
+			return solver.Answer{}, nil, err
+		}
+		sequence := findShortestSequence(code, 2)
+		total += len(sequence) * numericalPart(code)
+		witness = append(witness, CodePresses{Code: strings.Join(code, ""), Presses: strings.Join(sequence, "")})
+	}
+	return solver.IntAnswer(total), witness, nil
+}
+
+// findShortestSequence finds a shortest sequence required to input the code, trying each way of moving between the
+// digits and expanding it through the directional robots.
+func findShortestSequence(code []string, directionalRobots int) []string {
+	shortest := []string{}
+	current := "A"
+	for _, next := range code {
+		var best []string
+		for _, sequence := range numericalToDirectional(current, next) {
+			for i := 0; i < directionalRobots; i++ {
+				sequence = expandDirectionalSequence(sequence)
+			}
+			if best == nil || len(sequence) < len(best) {
+				best = sequence
+			}
+		}
+		shortest = append(shortest, best...)
+		current = next
+	}
+	return shortest
+}
+
+// expandDirectionalSequence finds the best directional sequence that makes a robot at a directional keypad press the
+// buttons of a sequence.
+func expandDirectionalSequence(sequence []string) []string {
+	expanded := []string{}
+	current := "A"
+	for _, next := range sequence {
+		expanded = append(expanded, directionalToDirectional(current, next)...)
+		current = next
+	}
+	return expanded
+}
+
+// keypad is the layout of the buttons of a keypad, with a space for the gap.
+type keypad []string
+
+var (
+	numericalLayout   = keypad{"789", "456", "123", " 0A"}
+	directionalLayout = keypad{" ^A", "<v>"}
+)
+
+// press returns the buttons pressed by a robot arm at the keypad, which starts over A, when it is driven by the
+// buttons of presses.
+func (k keypad) press(presses string) (string, error) {
+	row, col := 0, 0
+	for i, buttons := range k {
+		if j := strings.IndexByte(buttons, 'A'); j != -1 {
+			row, col = i, j
+		}
+	}
+	typed := []byte{}
+	for _, press := range []byte(presses) {
+		switch press {
+		case '^':
+			row--
+		case 'v':
+			row++
+		case '<':
+			col--
+		case '>':
+			col++
+		case 'A':
+			typed = append(typed, k[row][col])
+			continue
+		default:
+			return "", fmt.Errorf("%q is not a button on the directional keypad", press)
+		}
+		if row < 0 || row >= len(k) || col < 0 || col >= len(k[row]) || k[row][col] == ' ' {
+			return "", errors.New("a robot arm is moved over a gap")
+		}
+	}
+	return string(typed), nil
+}
+
+// Verify checks that the presses for each code in the witness make the chain of two robots at directional keypads
+// and one at the numeric keypad type the code, and that the complexities add up to the answer. It cannot show that
+// the sequences are the shortest.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	switch part {
+	case 1:
+	case 2:
+		return solver.ErrNoWitness
+	default:
+		return solver.ErrNoPart
+	}
+	presses, ok := witness.(KeyPresses)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not day 21 key presses", witness)
+	}
+	if len(presses) != len(s.codes) {
+		return fmt.Errorf("the witness has presses for %d codes, not %d", len(presses), len(s.codes))
+	}
+
+	total := 0
+	for i, code := range presses {
+		if want := strings.Join(s.codes[i], ""); code.Code != want {
+			return fmt.Errorf("code %d is %s, not %s", i+1, want, code.Code)
+		}
+		typed := code.Presses
+		for _, layout := range []keypad{directionalLayout, directionalLayout, numericalLayout} {
+			var err error
+			if typed, err = layout.press(typed); err != nil {
+				return fmt.Errorf("code %s: %w", code.Code, err)
+			}
+		}
+		if typed != code.Code {
+			return fmt.Errorf("the presses for code %s type %s", code.Code, typed)
+		}
+		number, err := strconv.Atoi(strings.TrimSuffix(code.Code, "A"))
+		if err != nil {
+			return err
+		}
+		total += len(code.Presses) * number
+	}
+
+	if answer.Kind() != solver.Int || answer.Int() != total {
+		return fmt.Errorf("the complexities add up to %d, not %s", total, answer)
+	}
+	return nil
+}
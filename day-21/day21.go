@@ -1,61 +1,151 @@
-package main
+package day21
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
-	"strconv"
+	"context"
+	"io"
 	"strings"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
 )
 
 var numericalKeypad = [][]string{{"7", "8", "9"}, {"4", "5", "6"}, {"1", "2", "3"}, {"", "0", "A"}}
 
-func main() {
-	codes, err := readLines("input.txt")
+// Solver solves the puzzle for day 21.
+type Solver struct {
+	// PartTwoRobots is the number of robots using directional keypads in part two.
+	PartTwoRobots int `param:"partTwoRobots"`
+
+	codes  [][]string
+	bigInt bool
+}
+
+// New creates a solver for the puzzle for day 21.
+func New() *Solver {
+	return &Solver{PartTwoRobots: 25}
+}
+
+// maxRobots is the largest number of robots using directional keypads, beyond which even big integers take too long
+// to add up the lengths of the sequences.
+const maxRobots = 1000
+
+// Validate checks that from 0 to maxRobots robots use directional keypads in part two.
+func (s *Solver) Validate() error {
+	return solver.CheckRange("partTwoRobots", s.PartTwoRobots, 0, maxRobots)
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	codes, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.codes = codes
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.codes)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	if s.bigInt {
+		answer, err := bigComplexity(ctx, s.codes, s.PartTwoRobots)
+		if err != nil {
+			return solver.Answer{}, err
+		}
+
+		return solver.BigAnswer(answer), nil
+	}
+	answer, err := partTwo(ctx, s.codes, s.PartTwoRobots)
 	if err != nil {
-		log.Fatal(err)
+		return solver.Answer{}, err
 	}
 
-	fmt.Println(partOne(codes))
-	fmt.Println(partTwo(codes))
+	return solver.IntAnswer(answer), nil
 }
 
 // partOne solves part one of the puzzle.
-func partOne(codes [][]string) int {
+func partOne(ctx context.Context, codes [][]string) (int, error) {
 	total := 0
 	for _, code := range codes {
-		shortestSequenceLength := findShortestSequenceLength(code, 2)
-		total += shortestSequenceLength * numericalPart(code)
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		shortestSequenceLength, err := findShortestSequenceLength(code, 2)
+		if err != nil {
+			return 0, err
+		}
+		if total, err = addComplexity(total, shortestSequenceLength, code); err != nil {
+			return 0, err
+		}
 	}
-	return total
+	return total, nil
 }
 
 // partTwo solves part two of the puzzle.
-func partTwo(codes [][]string) int {
+func partTwo(ctx context.Context, codes [][]string, directionalRobots int) (int, error) {
 	total := 0
 	for _, code := range codes {
-		shortestSequenceLength := findShortestSequenceLength(code, 25)
-		total += shortestSequenceLength * numericalPart(code)
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		shortestSequenceLength, err := findShortestSequenceLength(code, directionalRobots)
+		if err != nil {
+			return 0, err
+		}
+		if total, err = addComplexity(total, shortestSequenceLength, code); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// addComplexity adds the complexity of a code, the length of the sequence that types it multiplied by its numerical
+// part, to a total.
+func addComplexity(total, sequenceLength int, code []string) (int, error) {
+	complexity, err := checked.Mul(sequenceLength, numericalPart(code))
+	if err != nil {
+		return 0, err
 	}
-	return total
+	return checked.Add(total, complexity)
 }
 
 // findShortestSequenceLength finds the shortest sequence required to input the code.
-func findShortestSequenceLength(code []string, directionalRobots int) int {
+func findShortestSequenceLength(code []string, directionalRobots int) (int, error) {
 	length := 0
 	current := "A"
 	for i := 0; i < len(code); i++ {
 		next := code[i]
-		length += findMinimumDirectionPresses(current, next, directionalRobots)
+		presses, err := findMinimumDirectionPresses(current, next, directionalRobots)
+		if err != nil {
+			return 0, err
+		}
+		if length, err = checked.Add(length, presses); err != nil {
+			return 0, err
+		}
 		current = next
 	}
 
-	return length
+	return length, nil
 }
 
 // findMinimumDirectionPresses finds the fewest presses required to input the next digit.
-func findMinimumDirectionPresses(current, next string, directionalRobots int) int {
+func findMinimumDirectionPresses(current, next string, directionalRobots int) (int, error) {
 	directionalSequences := numericalToDirectional(current, next)
 	minPresses := -1
 	for _, sequence := range directionalSequences {
@@ -73,7 +163,11 @@ func findMinimumDirectionPresses(current, next string, directionalRobots int) in
 				current = "A"
 				for i := 0; i < len(newDirectionalSequence); i++ {
 					next := newDirectionalSequence[i]
-					newPairs[[2]string{current, next}] += count
+					newPair := [2]string{current, next}
+					var err error
+					if newPairs[newPair], err = checked.Add(newPairs[newPair], count); err != nil {
+						return 0, err
+					}
 					current = next
 				}
 			}
@@ -81,14 +175,17 @@ func findMinimumDirectionPresses(current, next string, directionalRobots int) in
 		}
 		pressCount := 0
 		for _, count := range pairs {
-			pressCount += count
+			var err error
+			if pressCount, err = checked.Add(pressCount, count); err != nil {
+				return 0, err
+			}
 		}
 		if minPresses == -1 || pressCount < minPresses {
 			minPresses = pressCount
 		}
 	}
 
-	return minPresses
+	return minPresses, nil
 }
 
 // directionalToDirectional finds the best directional sequence that gets from current to next on the directional keypad.
@@ -274,30 +371,27 @@ func numericalPart(code []string) int {
 	numbersStr := code[:len(code)-1]
 	numericalPart := 0
 	for _, numberStr := range numbersStr {
-		number, err := strconv.Atoi(numberStr)
-		if err != nil {
-			log.Fatal(err)
-		}
-
 		numericalPart *= 10
-		numericalPart += number
+		numericalPart += int(numberStr[0] - '0')
 	}
 	return numericalPart
 }
 
-// readLines converts the information from the file into a usable form.
-func readLines(fileName string) ([][]string, error) {
-	file, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([][]string, error) {
+	scanner := parse.NewScanner(r)
 
 	codes := [][]string{}
 	for scanner.Scan() {
-		codes = append(codes, strings.Split(scanner.Text(), ""))
+		line := scanner.Line()
+		numbers, err := line.TrimSuffix("A")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := numbers.Digits(); err != nil {
+			return nil, err
+		}
+		codes = append(codes, strings.Split(line.Text, ""))
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, err
@@ -0,0 +1,73 @@
+package day21
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/checked"
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGoldenBigInt(t *testing.T) {
+	solvertest.GoldenBigInt(t, New)
+}
+
+func TestOverflow(t *testing.T) {
+	// With 60 robots the sequences are far too long for an int to count their presses.
+	params := map[string]int{"partTwoRobots": 60}
+	s := New()
+	if err := solvertest.Parse(s, "test_data.txt", params); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PartTwo(context.Background()); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("got error %v, want %v", err, checked.ErrOverflow)
+	}
+
+	s.SetBigInt(true)
+	answer, err := s.PartTwo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if answer.Kind() != solver.Big {
+		t.Errorf("got %s answer %s, want a big answer", answer.Kind(), answer)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestCertify(t *testing.T) {
+	answer, witness := solvertest.Certified(t, New, "test_data.txt", nil, 1)
+
+	s := New()
+	if err := solvertest.Parse(s, "test_data.txt", nil); err != nil {
+		t.Fatal(err)
+	}
+	presses := slices.Clone(witness.(KeyPresses))
+	// Pressing ^ first moves the arm of the first robot off the top of its keypad.
+	first := presses[0].Presses
+	presses[0].Presses = "^" + first
+	if err := s.Verify(1, answer, presses); err == nil {
+		t.Errorf("verified presses %s for code %s", presses[0].Presses, presses[0].Code)
+	}
+	presses[0].Presses = first + "A"
+	if err := s.Verify(1, answer, presses); err == nil {
+		t.Errorf("verified presses %s for code %s", presses[0].Presses, presses[0].Code)
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
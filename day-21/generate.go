@@ -0,0 +1,33 @@
+package day21
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 21.
+type Generator struct {
+	// Codes is the number of door codes.
+	Codes int `param:"codes"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Codes: 5}
+}
+
+// Generate writes a random puzzle input of three-digit codes ending in A.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Codes < 0 {
+		return fmt.Errorf("codes must not be negative, not %d", g.Codes)
+	}
+
+	var builder strings.Builder
+	for i := 0; i < g.Codes; i++ {
+		fmt.Fprintf(&builder, "%03dA\n", rng.IntN(1000))
+	}
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
@@ -0,0 +1,115 @@
+package day21
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+// referenceKeypads are the layouts of the numeric and directional keypads, with a space for each gap.
+var referenceKeypads = [2][]string{{"789", "456", "123", " 0A"}, {" ^A", "<v>"}}
+
+// referenceKeyPosition finds the row and column of a key on a keypad.
+func referenceKeyPosition(keypad []string, key byte) (int, int) {
+	for row, keys := range keypad {
+		if col := strings.IndexByte(keys, key); col != -1 {
+			return row, col
+		}
+	}
+	panic(fmt.Sprintf("no key %q", key))
+}
+
+// referenceState is the key that each robot's arm is over, from the numeric keypad outwards, and how much of the
+// code has been typed.
+type referenceState struct {
+	arms  string
+	typed int
+}
+
+// referencePress presses a key on the keypad that the person uses, returning the new state, or false if the press
+// points an arm at a gap or types the wrong key.
+func referencePress(state referenceState, key byte, code string) (referenceState, bool) {
+	arms := []byte(state.arms)
+	for level := len(arms) - 1; level >= 0; level-- {
+		keypad := referenceKeypads[min(level, 1)]
+		if key != 'A' {
+			row, col := referenceKeyPosition(keypad, arms[level])
+			switch key {
+			case '^':
+				row--
+			case 'v':
+				row++
+			case '<':
+				col--
+			case '>':
+				col++
+			}
+			if row < 0 || row >= len(keypad) || col < 0 || col >= len(keypad[row]) || keypad[row][col] == ' ' {
+				return referenceState{}, false
+			}
+			arms[level] = keypad[row][col]
+			return referenceState{arms: string(arms), typed: state.typed}, true
+		}
+		key = arms[level]
+	}
+	if key != code[state.typed] {
+		return referenceState{}, false
+	}
+	return referenceState{arms: state.arms, typed: state.typed + 1}, true
+}
+
+// referenceSequenceLength finds the fewest presses that type a code through a chain of robots by searching every
+// position that the robots' arms can be in.
+func referenceSequenceLength(code string, directionalRobots int) int {
+	start := referenceState{arms: strings.Repeat("A", directionalRobots+1)}
+	seen := map[referenceState]bool{start: true}
+	states := []referenceState{start}
+	for presses := 1; len(states) > 0; presses++ {
+		next := []referenceState{}
+		for _, state := range states {
+			for _, key := range []byte("^v<>A") {
+				newState, ok := referencePress(state, key, code)
+				if !ok || seen[newState] {
+					continue
+				}
+				if newState.typed == len(code) {
+					return presses
+				}
+				seen[newState] = true
+				next = append(next, newState)
+			}
+		}
+		states = next
+	}
+	return -1
+}
+
+func TestSequenceLengthMatchesReference(t *testing.T) {
+	for directionalRobots := 0; directionalRobots <= 3; directionalRobots++ {
+		t.Run(fmt.Sprintf("directionalRobots=%d", directionalRobots), func(t *testing.T) {
+			solvertest.Differential(t, &Generator{Codes: 3}, New,
+				func(s *Solver) (solver.Answer, error) {
+					lengths := []string{}
+					for _, code := range s.codes {
+						length, err := findShortestSequenceLength(code, directionalRobots)
+						if err != nil {
+							return solver.Answer{}, err
+						}
+						lengths = append(lengths, fmt.Sprint(length))
+					}
+					return solver.StringAnswer(strings.Join(lengths, ",")), nil
+				},
+				func(s *Solver) (solver.Answer, error) {
+					lengths := []string{}
+					for _, code := range s.codes {
+						lengths = append(lengths,
+							fmt.Sprint(referenceSequenceLength(strings.Join(code, ""), directionalRobots)))
+					}
+					return solver.StringAnswer(strings.Join(lengths, ",")), nil
+				})
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package day22
+
+import (
+	"context"
+	"io"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 22.
+type Solver struct {
+	// Iterations is the number of new secret numbers each buyer generates.
+	Iterations int `param:"iterations"`
+
+	secretNumbers []int
+}
+
+// New creates a solver for the puzzle for day 22.
+func New() *Solver {
+	return &Solver{Iterations: 2000}
+}
+
+// maxIterations is the largest number of secret numbers each buyer generates, which keeps each part under a minute for
+// an input the size of the real puzzle.
+const maxIterations = 20000
+
+// Validate checks that each buyer generates from 1 to maxIterations secret numbers.
+func (s *Solver) Validate() error {
+	return solver.CheckRange("iterations", s.Iterations, 1, maxIterations)
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	secretNumbers, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.secretNumbers = secretNumbers
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.secretNumbers, s.Iterations)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.secretNumbers, s.Iterations)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, secretNumbers []int, iterations int) (int, error) {
+	total := 0
+	for _, secretNumber := range secretNumbers {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for i := 1; i <= iterations; i++ {
+			secretNumber = newSecretNumber(secretNumber)
+		}
+		total += secretNumber
+	}
+	return total, nil
+}
+
+// partTwo solves part two of the puzzle.
+func partTwo(ctx context.Context, secretNumbers []int, iterations int) (int, error) {
+	changeMaps := []map[[4]int]int{}
+	for _, secretNumber := range secretNumbers {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		changeMap := map[[4]int]int{}
+		numbers := []int{secretNumber}
+		for i := 1; i <= iterations; i++ {
+			numbers = append(numbers, newSecretNumber(numbers[len(numbers)-1]))
+			if i >= 4 {
+				changes := [4]int{(numbers[i-3] % 10) - (numbers[i-4] % 10), (numbers[i-2] % 10) - (numbers[i-3] % 10),
+					(numbers[i-1] % 10) - (numbers[i-2] % 10), (numbers[i] % 10) - (numbers[i-1] % 10)}
+				if _, ok := changeMap[changes]; !ok {
+					changeMap[changes] = numbers[i] % 10
+				}
+			}
+		}
+		changeMaps = append(changeMaps, changeMap)
+	}
+	overallMap := map[[4]int]int{}
+	for _, changeMap := range changeMaps {
+		for key, value := range changeMap {
+			overallMap[key] += value
+		}
+	}
+	max := -1
+	for _, value := range overallMap {
+		if max == -1 || value > max {
+			max = value
+		}
+	}
+	return max, nil
+}
+
+// newSecretNumber performs an evolution of a secret number.
+func newSecretNumber(secretNumber int) int {
+	secretNumber = mixAndPrune(secretNumber, secretNumber*64)
+	secretNumber = mixAndPrune(secretNumber, secretNumber/32)
+	return mixAndPrune(secretNumber, secretNumber*2048)
+}
+
+// mixAndPrune performs the mix and prune operations.
+func mixAndPrune(secretNumber, secondValue int) int {
+	secretNumber = secretNumber ^ secondValue
+	secretNumber = secretNumber % 16777216
+	return secretNumber
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([]int, error) {
+	scanner := parse.NewScanner(r)
+
+	secretNumbers := []int{}
+	for scanner.Scan() {
+		number, err := scanner.Line().Int()
+		if err != nil {
+			return nil, err
+		}
+
+		secretNumbers = append(secretNumbers, number)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return secretNumbers, nil
+}
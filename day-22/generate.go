@@ -0,0 +1,33 @@
+package day22
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 22.
+type Generator struct {
+	// Buyers is the number of buyers.
+	Buyers int `param:"buyers"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Buyers: 2000}
+}
+
+// Generate writes a random puzzle input of initial secret numbers, which are below 16777216 like every later one.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Buyers < 0 {
+		return fmt.Errorf("buyers must not be negative, not %d", g.Buyers)
+	}
+
+	var builder strings.Builder
+	for i := 0; i < g.Buyers; i++ {
+		fmt.Fprintf(&builder, "%d\n", 1+rng.IntN(16777215))
+	}
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
@@ -0,0 +1,89 @@
+package day23
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Clique is the witness for part two of day 23: the computers at the LAN party, which are all connected to each other.
+type Clique []string
+
+// String lists the computers.
+func (c Clique) String() string {
+	return strings.Join(c, "\n")
+}
+
+// Certify solves a part of the puzzle along with the largest clique for part two.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	switch part {
+	case 1:
+		return solver.Answer{}, nil, solver.ErrNoWitness
+	case 2:
+	default:
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	connectionMap := constructConnectionMap(s.connections)
+	computerMap := constructComputerMap(s.connections)
+	cliques, err := maximalCliques(ctx, []string{}, computerMap, map[string]bool{}, connectionMap)
+	if err != nil {
+		return solver.Answer{}, nil, err
+	}
+	longestClique := slices.Clone(longest(cliques))
+	slices.Sort(longestClique)
+	return solver.StringAnswer(strings.Join(longestClique, ",")), Clique(longestClique), nil
+}
+
+// Verify checks that the computers in the witness are all connected to each other and to no other computer in common,
+// and that their names in order are the answer. It cannot show that there is no larger clique.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	switch part {
+	case 1:
+		return solver.ErrNoWitness
+	case 2:
+	default:
+		return solver.ErrNoPart
+	}
+	clique, ok := witness.(Clique)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not a day 23 clique", witness)
+	}
+	if len(clique) == 0 {
+		return errors.New("the clique is empty")
+	}
+
+	connected := map[[2]string]bool{}
+	for _, connection := range s.connections {
+		connected[connection] = true
+		connected[[2]string{connection[1], connection[0]}] = true
+	}
+	for i, first := range clique {
+		for _, second := range clique[i+1:] {
+			if !connected[[2]string{first, second}] {
+				return fmt.Errorf("%s and %s are not connected", first, second)
+			}
+		}
+	}
+	members := map[string]bool{}
+	for _, computer := range clique {
+		members[computer] = true
+	}
+	for connection := range connected {
+		if computer := connection[0]; !members[computer] && connection[1] == clique[0] {
+			if !slices.ContainsFunc(clique, func(member string) bool { return !connected[[2]string{computer, member}] }) {
+				return fmt.Errorf("%s is connected to every computer in the clique", computer)
+			}
+		}
+	}
+
+	want := strings.Join(slices.Sorted(slices.Values(clique)), ",")
+	if answer.Kind() != solver.String || answer.String() != want {
+		return fmt.Errorf("the clique gives the password %s, not %s", want, answer)
+	}
+	return nil
+}
@@ -1,26 +1,64 @@
-package main
+package day23
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
+	"context"
+	"io"
 	"slices"
 	"strings"
+
+	"github.com/markcooper37/aoc-2024/events"
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
 )
 
-func main() {
-	connections, err := readLines("input.txt")
+// Solver solves the puzzle for day 23.
+type Solver struct {
+	connections [][2]string
+}
+
+// New creates a solver for the puzzle for day 23.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	connections, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.connections = connections
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.connections)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.connections)
 	if err != nil {
-		log.Fatal(err)
+		return solver.Answer{}, err
 	}
 
-	fmt.Println(partOne(connections))
-	fmt.Println(partTwo(connections))
+	return solver.StringAnswer(answer), nil
 }
 
 // partOne solves part one of the puzzle.
-func partOne(connections [][2]string) int {
+func partOne(ctx context.Context, connections [][2]string) (int, error) {
 	sets := interconnectedTrios(connections)
 	total := 0
 	for set := range sets {
@@ -28,17 +66,20 @@ func partOne(connections [][2]string) int {
 			total++
 		}
 	}
-	return total
+	return total, nil
 }
 
 // partTwo solves part two of the puzzle.
-func partTwo(connections [][2]string) string {
+func partTwo(ctx context.Context, connections [][2]string) (string, error) {
 	connectionMap := constructConnectionMap(connections)
 	computerMap := constructComputerMap(connections)
-	cliques := maximalCliques([]string{}, computerMap, map[string]bool{}, connectionMap)
+	cliques, err := maximalCliques(ctx, []string{}, computerMap, map[string]bool{}, connectionMap)
+	if err != nil {
+		return "", err
+	}
 	longestClique := longest(cliques)
 	slices.Sort(longestClique)
-	return strings.Join(longestClique, ",")
+	return strings.Join(longestClique, ","), nil
 }
 
 // interconnectedTrios creates a list of all interconnected trios.
@@ -83,13 +124,21 @@ func constructComputerMap(connections [][2]string) map[string]bool {
 	return computerMap
 }
 
-// maximalCliques finds all maximal cliques.
-func maximalCliques(clique []string, options, excluded map[string]bool, connectionMap map[string]map[string]bool) [][]string {
+// maximalCliques finds all maximal cliques. Each call is reported to the tracer as a recurse event.
+func maximalCliques(ctx context.Context, clique []string, options, excluded map[string]bool,
+	connectionMap map[string]map[string]bool) ([][]string, error) {
+	if tracer := events.FromContext(ctx); tracer.Enabled() {
+		tracer.Emit("recurse", events.Int("depth", len(clique)), events.String("clique", strings.Join(clique, ",")),
+			events.Int("options", len(options)), events.Int("excluded", len(excluded)))
+	}
 	if len(options) == 0 && len(excluded) == 0 {
-		return [][]string{clique}
+		return [][]string{clique}, nil
 	}
 	maxCliques := [][]string{}
 	for vertex := range options {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		newClique := make([]string, len(clique))
 		copy(newClique, clique)
 		newClique = append(newClique, vertex)
@@ -104,15 +153,19 @@ func maximalCliques(clique []string, options, excluded map[string]bool, connecti
 		newExcluded := map[string]bool{}
 		for k := range excluded {
 			if connectionMap[vertex][k] {
-				newExcluded[vertex] = true
+				newExcluded[k] = true
 			}
 		}
-		
-		maxCliques = append(maxCliques, maximalCliques(newClique, newOptions, newExcluded, connectionMap)...)
+
+		newCliques, err := maximalCliques(ctx, newClique, newOptions, newExcluded, connectionMap)
+		if err != nil {
+			return nil, err
+		}
+		maxCliques = append(maxCliques, newCliques...)
 		delete(options, vertex)
 		excluded[vertex] = true
 	}
-	return maxCliques
+	return maxCliques, nil
 }
 
 // longest returns the longest slice.
@@ -126,20 +179,17 @@ func longest(slices [][]string) []string {
 	return longest
 }
 
-// readLines converts the information from the file into a usable form.
-func readLines(fileName string) ([][2]string, error) {
-	file, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([][2]string, error) {
+	scanner := parse.NewScanner(r)
 
 	connections := [][2]string{}
 	for scanner.Scan() {
-		parts := strings.Split(scanner.Text(), "-")
-		connections = append(connections, [2]string{parts[0], parts[1]})
+		parts, err := scanner.Line().SplitN("-", 2, `a connection such as "kh-tc"`)
+		if err != nil {
+			return nil, err
+		}
+		connections = append(connections, [2]string{parts[0].Text, parts[1].Text})
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, err
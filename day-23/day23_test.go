@@ -0,0 +1,42 @@
+package day23
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestCertify(t *testing.T) {
+	answer, witness := solvertest.Certified(t, New, "test_data.txt", nil, 2)
+
+	s := New()
+	if err := solvertest.Parse(s, "test_data.txt", nil); err != nil {
+		t.Fatal(err)
+	}
+	clique := witness.(Clique)
+	if err := s.Verify(2, answer, append(slices.Clone(clique), "tc")); err == nil {
+		t.Error("verified a clique with a computer that is not connected to the others")
+	}
+	if err := s.Verify(2, solver.StringAnswer(strings.Join(clique[1:], ",")), clique[1:]); err == nil {
+		t.Error("verified a clique that could be extended")
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
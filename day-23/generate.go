@@ -0,0 +1,71 @@
+package day23
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 23.
+type Generator struct {
+	// Computers is the number of computers, each with a distinct two-letter name.
+	Computers int `param:"computers"`
+	// Degree is the number of other computers each one connects to at random.
+	Degree int `param:"degree"`
+	// LanParty is the number of computers in a planted group that are all connected to each other.
+	LanParty int `param:"lanParty"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Computers: 520, Degree: 10, LanParty: 13}
+}
+
+// Generate writes a random puzzle input. Random connections rarely form a large group, so one is planted among
+// them for part two to find.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Computers < 1 || g.Computers > 26*26 {
+		return fmt.Errorf("computers must be from 1 to %d, not %d", 26*26, g.Computers)
+	}
+	if g.Degree < 0 || g.Degree >= g.Computers {
+		return fmt.Errorf("degree must be from 0 to %d, not %d", g.Computers-1, g.Degree)
+	}
+	if g.LanParty < 0 || g.LanParty > g.Computers {
+		return fmt.Errorf("lanParty must be from 0 to %d, not %d", g.Computers, g.LanParty)
+	}
+
+	names := []string{}
+	for _, index := range rng.Perm(26 * 26)[:g.Computers] {
+		names = append(names, string([]byte{byte('a' + index/26), byte('a' + index%26)}))
+	}
+
+	connected := map[[2]int]bool{}
+	connections := [][2]int{}
+	connect := func(a, b int) {
+		if a == b || connected[[2]int{a, b}] || connected[[2]int{b, a}] {
+			return
+		}
+		connected[[2]int{a, b}] = true
+		connections = append(connections, [2]int{a, b})
+	}
+	for a := range names {
+		for i := 0; i < g.Degree/2; i++ {
+			connect(a, rng.IntN(len(names)))
+		}
+	}
+	// The names are already in a random order, so the first of them make a random group.
+	for a := 0; a < g.LanParty; a++ {
+		for b := a + 1; b < g.LanParty; b++ {
+			connect(a, b)
+		}
+	}
+	rng.Shuffle(len(connections), func(i, j int) { connections[i], connections[j] = connections[j], connections[i] })
+
+	var builder strings.Builder
+	for _, connection := range connections {
+		fmt.Fprintf(&builder, "%s-%s\n", names[connection[0]], names[connection[1]])
+	}
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
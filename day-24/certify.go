@@ -0,0 +1,235 @@
+package day24
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Swaps is the witness for part two of day 24: the pairs of gates whose output wires were swapped.
+type Swaps [][2]string
+
+// String lists the pairs of swapped wires.
+func (s Swaps) String() string {
+	lines := []string{}
+	for _, pair := range s {
+		lines = append(lines, pair[0]+" <-> "+pair[1])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Certify solves a part of the puzzle along with the swaps for part two, found by trying each way of pairing up the
+// misplaced wires until the gates add correctly.
+func (s *Solver) Certify(ctx context.Context, part int) (solver.Answer, solver.Witness, error) {
+	switch part {
+	case 1:
+		return solver.Answer{}, nil, solver.ErrNoWitness
+	case 2:
+	default:
+		return solver.Answer{}, nil, solver.ErrNoPart
+	}
+
+	answer, err := partTwo(ctx, s.startWires, s.gates)
+	if err != nil {
+		return solver.Answer{}, nil, err
+	}
+	swappedWires := []string{}
+	if answer != "" {
+		swappedWires = strings.Split(answer, ",")
+	}
+	for _, swaps := range pairings(swappedWires) {
+		adds, err := s.adds(ctx, swapOutputs(s.gates, swaps))
+		if err != nil {
+			return solver.Answer{}, nil, err
+		}
+		if adds {
+			return solver.StringAnswer(answer), Swaps(swaps), nil
+		}
+	}
+	return solver.Answer{}, nil, errors.New("no way of swapping the misplaced wires makes the gates add")
+}
+
+// pairings returns every way of splitting the wires into pairs.
+func pairings(wires []string) [][][2]string {
+	if len(wires) == 0 {
+		return [][][2]string{{}}
+	}
+	all := [][][2]string{}
+	for i := 1; i < len(wires); i++ {
+		rest := append(slices.Clone(wires[1:i]), wires[i+1:]...)
+		for _, pairs := range pairings(rest) {
+			all = append(all, append([][2]string{{wires[0], wires[i]}}, pairs...))
+		}
+	}
+	return all
+}
+
+// swapOutputs returns a copy of the gates with the outputs of each pair swapped.
+func swapOutputs(gates []Gate, swaps [][2]string) []Gate {
+	partners := map[string]string{}
+	for _, pair := range swaps {
+		partners[pair[0]], partners[pair[1]] = pair[1], pair[0]
+	}
+	swapped := slices.Clone(gates)
+	for i, gate := range swapped {
+		if partner, ok := partners[gate.Output]; ok {
+			swapped[i].Output = partner
+		}
+	}
+	return swapped
+}
+
+// adds reports whether the gates add x and y for each of the adder tests.
+func (s *Solver) adds(ctx context.Context, gates []Gate) (bool, error) {
+	bits := inputBits(s.startWires)
+	allWires := allWires(s.startWires, gates)
+	for _, test := range adderTests(bits) {
+		completedWires, err := simulate(ctx, setInputs(bits, test), gates, allWires)
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		} else if err != nil {
+			return false, nil
+		}
+		output := 0
+		for index, wire := range zWires(allWires) {
+			output = output | (completedWires[wire] << index)
+		}
+		if output != test[0]+test[1] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// inputBits returns the number of bits in each of the x and y inputs.
+func inputBits(startWires map[string]int) int {
+	bits := 0
+	for wire := range startWires {
+		if wire[0] == 'x' {
+			bits++
+		}
+	}
+	return bits
+}
+
+// setInputs returns the values of the x and y wires for a pair of numbers.
+func setInputs(bits int, numbers [2]int) map[string]int {
+	wires := map[string]int{}
+	for i := 0; i < bits; i++ {
+		wires[fmt.Sprintf("x%02d", i)] = numbers[0] >> i & 1
+		wires[fmt.Sprintf("y%02d", i)] = numbers[1] >> i & 1
+	}
+	return wires
+}
+
+// adderTests returns pairs of numbers that exercise every bit of an adder: each bit set in either input and in both,
+// so that it carries, every bit set in both, and a few random pairs.
+func adderTests(bits int) [][2]int {
+	mask := 1<<bits - 1
+	tests := [][2]int{{mask, mask}}
+	for i := 0; i < bits; i++ {
+		tests = append(tests, [2]int{1 << i, 0}, [2]int{0, 1 << i}, [2]int{1 << i, 1 << i})
+	}
+	rng := rand.New(rand.NewPCG(24, 24))
+	for range 20 {
+		tests = append(tests, [2]int{rng.IntN(mask + 1), rng.IntN(mask + 1)})
+	}
+	return tests
+}
+
+// Verify checks that each wire in the witness is the output of a gate and is swapped only once, that with their
+// outputs swapped the gates add x and y for numbers exercising every bit, and that the wire names in order are the
+// answer. The gates are evaluated by working back from each z wire rather than by simulating them.
+func (s *Solver) Verify(part int, answer solver.Answer, witness solver.Witness) error {
+	switch part {
+	case 1:
+		return solver.ErrNoWitness
+	case 2:
+	default:
+		return solver.ErrNoPart
+	}
+	swaps, ok := witness.(Swaps)
+	if !ok {
+		return fmt.Errorf("witness is a %T, not day 24 swaps", witness)
+	}
+
+	drivers := map[string]Gate{}
+	for _, gate := range s.gates {
+		drivers[gate.Output] = gate
+	}
+	swappedWires := []string{}
+	for _, pair := range swaps {
+		for _, wire := range pair {
+			if _, ok := drivers[wire]; !ok || slices.Contains(swappedWires, wire) {
+				return fmt.Errorf("%s is not the output of a gate or is swapped more than once", wire)
+			}
+			swappedWires = append(swappedWires, wire)
+		}
+		drivers[pair[0]], drivers[pair[1]] = drivers[pair[1]], drivers[pair[0]]
+	}
+
+	outputs := []string{}
+	for wire := range drivers {
+		if wire[0] == 'z' {
+			outputs = append(outputs, wire)
+		}
+	}
+	slices.Sort(outputs)
+	bits := inputBits(s.startWires)
+	for _, test := range adderTests(bits) {
+		values := setInputs(bits, test)
+		evaluating := map[string]bool{}
+		var evaluate func(wire string) (int, error)
+		evaluate = func(wire string) (int, error) {
+			if value, ok := values[wire]; ok {
+				return value, nil
+			}
+			gate, ok := drivers[wire]
+			if !ok || evaluating[wire] {
+				return 0, fmt.Errorf("%s never receives a value", wire)
+			}
+			evaluating[wire] = true
+			first, err := evaluate(gate.Inputs[0])
+			if err != nil {
+				return 0, err
+			}
+			second, err := evaluate(gate.Inputs[1])
+			if err != nil {
+				return 0, err
+			}
+			switch gate.Operation {
+			case "AND":
+				values[wire] = first & second
+			case "OR":
+				values[wire] = first | second
+			default:
+				values[wire] = first ^ second
+			}
+			return values[wire], nil
+		}
+
+		sum := 0
+		for i, wire := range outputs {
+			value, err := evaluate(wire)
+			if err != nil {
+				return err
+			}
+			sum |= value << i
+		}
+		if sum != test[0]+test[1] {
+			return fmt.Errorf("the gates add %d and %d to make %d", test[0], test[1], sum)
+		}
+	}
+
+	slices.Sort(swappedWires)
+	want := strings.Join(swappedWires, ",")
+	if answer.Kind() != solver.String || answer.String() != want {
+		return fmt.Errorf("the swapped wires are %s, not %s", want, answer)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package day24
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 24.
+type Solver struct {
+	startWires map[string]int
+	gates      []Gate
+}
+
+// New creates a solver for the puzzle for day 24.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	startWires, gates, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.startWires, s.gates = startWires, gates
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.startWires, s.gates)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo solves part two of the puzzle.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	answer, err := partTwo(ctx, s.startWires, s.gates)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.StringAnswer(answer), nil
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, startWires map[string]int, gates []Gate) (int, error) {
+	allWires := allWires(startWires, gates)
+	completedWires, err := simulate(ctx, startWires, gates, allWires)
+	if err != nil {
+		return 0, err
+	}
+	zWires := zWires(allWires)
+	output := 0
+	for index, wire := range zWires {
+		output = output | (completedWires[wire] << index)
+	}
+
+	return output, nil
+}
+
+// simulate finds the value of every wire once the gates have all produced their outputs.
+func simulate(ctx context.Context, startWires map[string]int, gates []Gate, allWires map[string]bool) (map[string]int, error) {
+	completedWires := map[string]int{}
+	for wire, value := range startWires {
+		completedWires[wire] = value
+	}
+	for len(completedWires) < len(allWires) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		completed := len(completedWires)
+		for _, gate := range gates {
+			if input1, input1Exists := completedWires[gate.Inputs[0]]; input1Exists {
+				if input2, input2Exists := completedWires[gate.Inputs[1]]; input2Exists {
+					completedWires[gate.Output] = calculateOutput([2]int{input1, input2}, gate.Operation)
+				}
+			}
+		}
+		if len(completedWires) == completed {
+			return nil, errors.New("some wires never receive a value")
+		}
+	}
+	return completedWires, nil
+}
+
+// partTwo solves part two of the puzzle. The gates should form a ripple-carry adder, in which each bit of the output
+// is z = (x XOR y) XOR carry and the carry into the next bit is (x AND y) OR ((x XOR y) AND carry), so any gate whose
+// output is not used the way that structure needs must have had its output swapped.
+func partTwo(ctx context.Context, startWires map[string]int, gates []Gate) (string, error) {
+	zWires := zWires(allWires(startWires, gates))
+	if len(zWires) == 0 {
+		return "", errors.New("no gate outputs to a z wire")
+	}
+	lastZWire := zWires[len(zWires)-1]
+	operationsFed := map[string][]string{}
+	for _, gate := range gates {
+		for _, input := range gate.Inputs {
+			operationsFed[input] = append(operationsFed[input], gate.Operation)
+		}
+	}
+
+	swappedWires := []string{}
+	for _, gate := range gates {
+		if misplacedOutput(gate, lastZWire, operationsFed) {
+			swappedWires = append(swappedWires, gate.Output)
+		}
+	}
+	slices.Sort(swappedWires)
+	return strings.Join(swappedWires, ","), nil
+}
+
+// misplacedOutput reports whether the output of a gate is used differently from how a ripple-carry adder would use it.
+func misplacedOutput(gate Gate, lastZWire string, operationsFed map[string][]string) bool {
+	fromInputs := isInputWire(gate.Inputs[0]) && isInputWire(gate.Inputs[1])
+	firstBit := slices.Contains(gate.Inputs[:], "x00")
+	switch {
+	case gate.Output == lastZWire:
+		// The highest bit of the output is the final carry.
+		return gate.Operation != "OR"
+	case gate.Output[0] == 'z':
+		return gate.Operation != "XOR"
+	case gate.Operation == "XOR" && !fromInputs:
+		// Adding the carry to the sum of the inputs always produces a bit of the output.
+		return true
+	case gate.Operation == "XOR" && !firstBit:
+		return !slices.Contains(operationsFed[gate.Output], "XOR")
+	case gate.Operation == "AND" && !firstBit:
+		return !slices.Contains(operationsFed[gate.Output], "OR")
+	}
+	return false
+}
+
+// isInputWire reports whether a wire is one of the x or y inputs of the adder.
+func isInputWire(wire string) bool {
+	return wire[0] == 'x' || wire[0] == 'y'
+}
+
+type Gate struct {
+	Inputs    [2]string
+	Operation string
+	Output    string
+}
+
+func allWires(startWires map[string]int, gates []Gate) map[string]bool {
+	allWires := map[string]bool{}
+	for wire := range startWires {
+		allWires[wire] = true
+	}
+	for _, gate := range gates {
+		allWires[gate.Inputs[0]] = true
+		allWires[gate.Inputs[1]] = true
+		allWires[gate.Output] = true
+	}
+	return allWires
+}
+
+func zWires(allWires map[string]bool) []string {
+	zWires := []string{}
+	for wire := range allWires {
+		if wire[0] == 'z' {
+			zWires = append(zWires, wire)
+		}
+	}
+	slices.Sort(zWires)
+	return zWires
+}
+
+func calculateOutput(inputs [2]int, operation string) int {
+	switch operation {
+	case "AND":
+		return inputs[0] & inputs[1]
+	case "OR":
+		return inputs[0] | inputs[1]
+	case "XOR":
+		return inputs[0] ^ inputs[1]
+	}
+	return 0
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) (map[string]int, []Gate, error) {
+	scanner := parse.NewScanner(r)
+
+	startWires := map[string]int{}
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			break
+		}
+		wire, valueStr, err := scanner.Line().Cut(": ", `a wire such as "x00: 1"`)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if wire.Text == "" {
+			return nil, nil, wire.Invalid("a wire name")
+		}
+		value, err := valueStr.Int()
+		if err != nil {
+			return nil, nil, err
+		}
+		if value != 0 && value != 1 {
+			return nil, nil, valueStr.Invalid("0 or 1")
+		}
+
+		startWires[wire.Text] = value
+	}
+	gates := []Gate{}
+	for scanner.Scan() {
+		parts, err := scanner.Line().SplitN(" ", 5, `a gate such as "x00 AND y00 -> z00"`)
+		if err != nil {
+			return nil, nil, err
+		}
+		if parts[1].Text != "AND" && parts[1].Text != "OR" && parts[1].Text != "XOR" {
+			return nil, nil, parts[1].Invalid("AND, OR or XOR")
+		}
+		if parts[3].Text != "->" {
+			return nil, nil, parts[3].Invalid(`"->"`)
+		}
+		for _, wire := range []parse.Line{parts[0], parts[2], parts[4]} {
+			if wire.Text == "" {
+				return nil, nil, wire.Invalid("a wire name")
+			}
+		}
+		gates = append(gates, Gate{
+			Inputs:    [2]string{parts[0].Text, parts[2].Text},
+			Operation: parts[1].Text,
+			Output:    parts[4].Text,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return startWires, gates, nil
+}
@@ -0,0 +1,82 @@
+package day24
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver/solvertest"
+)
+
+func TestGolden(t *testing.T) {
+	solvertest.Golden(t, New)
+}
+
+func TestGenerate(t *testing.T) {
+	solvertest.Generated(t, NewGenerator, New)
+}
+
+func TestInvalidInput(t *testing.T) {
+	for input, want := range map[string]string{
+		": 1\n\nx00 AND y00 -> z00\n":         `input:1:1: expected a wire name`,
+		"x00: 1\n\nx00 AND  -> z00\n":         `input:3:9: expected a wire name`,
+		"x00: 1\ny00: 0\n\nx00 AND y00 -> \n": `input:4:16: expected a wire name`,
+	} {
+		if err := New().Parse(strings.NewReader(input)); err == nil || err.Error() != want {
+			t.Errorf("%q: got error %v, want %q", input, err, want)
+		}
+	}
+
+	s := New()
+	if err := s.Parse(strings.NewReader("x00: 1\ny00: 0\n\nx00 AND y00 -> a00\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PartTwo(context.Background()); err == nil || err.Error() != "no gate outputs to a z wire" {
+		t.Errorf("got error %v, want no z wires", err)
+	}
+}
+
+func TestPartTwoFindsGeneratedSwaps(t *testing.T) {
+	for seed := uint64(1); seed <= 10; seed++ {
+		s := New()
+		if err := s.Parse(bytes.NewReader(solvertest.Generate(t, NewGenerator(), seed))); err != nil {
+			t.Fatal(err)
+		}
+		swapped, err := partTwo(context.Background(), s.startWires, s.gates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wires := strings.Split(swapped, ","); len(wires) != 8 {
+			t.Errorf("seed %d: found swapped wires %q, want 8", seed, wires)
+		}
+	}
+}
+
+func TestCertify(t *testing.T) {
+	if _, witness := solvertest.Certified(t, New, "fixed.txt", nil, 2); len(witness.(Swaps)) != 0 {
+		t.Errorf("got swaps %v for the fixed adder, want none", witness)
+	}
+	answer, witness := solvertest.Certified(t, New, "input.txt", nil, 2)
+
+	s := New()
+	if err := solvertest.Parse(s, "input.txt", nil); err != nil {
+		t.Fatal(err)
+	}
+	swaps := witness.(Swaps)
+	repaired := Swaps{{swaps[0][0], swaps[1][0]}, {swaps[0][1], swaps[1][1]}, swaps[2], swaps[3]}
+	if err := s.Verify(2, answer, repaired); err == nil {
+		t.Errorf("verified the wrong swaps %v", repaired)
+	}
+	if err := s.Verify(2, answer, swaps[1:]); err == nil {
+		t.Error("verified swaps that leave a pair of wires crossed")
+	}
+}
+
+func BenchmarkPartOne(b *testing.B) {
+	solvertest.Benchmark(b, New, 1)
+}
+
+func BenchmarkPartTwo(b *testing.B) {
+	solvertest.Benchmark(b, New, 2)
+}
@@ -0,0 +1,96 @@
+package day24
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 24.
+type Generator struct {
+	// Bits is the number of bits in each of the numbers being added.
+	Bits int `param:"bits"`
+	// Swaps is the number of pairs of gates whose outputs are swapped.
+	Swaps int `param:"swaps"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Bits: 45, Swaps: 4}
+}
+
+// Generate writes a random puzzle input: a ripple-carry adder of random x and y inputs, with the outputs of pairs of
+// gates swapped within distinct bits. The swaps are of the kinds that leave the gates without a cycle.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Bits < 2 {
+		return fmt.Errorf("bits must be at least 2, not %d", g.Bits)
+	}
+	if g.Swaps < 0 || g.Swaps > g.Bits-2 {
+		return fmt.Errorf("swaps must be from 0 to %d, not %d", g.Bits-2, g.Swaps)
+	}
+
+	used := map[string]bool{}
+	newWire := func() string {
+		for {
+			wire := string([]byte{byte('a' + rng.IntN(23)), byte('a' + rng.IntN(26)), byte('a' + rng.IntN(26))})
+			if !used[wire] {
+				used[wire] = true
+				return wire
+			}
+		}
+	}
+	bitWire := func(prefix byte, bit int) string {
+		return fmt.Sprintf("%c%02d", prefix, bit)
+	}
+
+	gates := []Gate{
+		{Inputs: [2]string{"x00", "y00"}, Operation: "XOR", Output: "z00"},
+		{Inputs: [2]string{"x00", "y00"}, Operation: "AND", Output: newWire()},
+	}
+	carry := gates[1].Output
+	swapBits := rng.Perm(g.Bits - 2)[:g.Swaps]
+	for bit := 1; bit < g.Bits; bit++ {
+		x, y := bitWire('x', bit), bitWire('y', bit)
+		sum, bothSet, carried, carryOut := newWire(), newWire(), newWire(), newWire()
+		if bit == g.Bits-1 {
+			carryOut = bitWire('z', g.Bits)
+		}
+		bitGates := []Gate{
+			{Inputs: [2]string{x, y}, Operation: "XOR", Output: sum},
+			{Inputs: [2]string{x, y}, Operation: "AND", Output: bothSet},
+			{Inputs: [2]string{sum, carry}, Operation: "XOR", Output: bitWire('z', bit)},
+			{Inputs: [2]string{sum, carry}, Operation: "AND", Output: carried},
+			{Inputs: [2]string{bothSet, carried}, Operation: "OR", Output: carryOut},
+		}
+		carry = carryOut
+		for _, swapBit := range swapBits {
+			if swapBit+1 != bit {
+				continue
+			}
+			// Each pair is the output bit with one of the gates not feeding it, or the sum of the inputs with
+			// their carry. Swapping the output bit with the sum would make a cycle.
+			pairs := [][2]int{{2, 1}, {2, 3}, {2, 4}, {0, 1}}
+			pair := pairs[rng.IntN(len(pairs))]
+			bitGates[pair[0]].Output, bitGates[pair[1]].Output = bitGates[pair[1]].Output, bitGates[pair[0]].Output
+		}
+		gates = append(gates, bitGates...)
+	}
+	rng.Shuffle(len(gates), func(i, j int) { gates[i], gates[j] = gates[j], gates[i] })
+
+	var builder strings.Builder
+	for _, prefix := range []byte{'x', 'y'} {
+		for bit := 0; bit < g.Bits; bit++ {
+			fmt.Fprintf(&builder, "%s: %d\n", bitWire(prefix, bit), rng.IntN(2))
+		}
+	}
+	builder.WriteString("\n")
+	for _, gate := range gates {
+		if rng.IntN(2) == 0 {
+			gate.Inputs[0], gate.Inputs[1] = gate.Inputs[1], gate.Inputs[0]
+		}
+		fmt.Fprintf(&builder, "%s %s %s -> %s\n", gate.Inputs[0], gate.Operation, gate.Inputs[1], gate.Output)
+	}
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
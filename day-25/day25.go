@@ -0,0 +1,158 @@
+package day25
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Solver solves the puzzle for day 25.
+type Solver struct {
+	schematics [][][]string
+}
+
+// New creates a solver for the puzzle for day 25.
+func New() *Solver {
+	return &Solver{}
+}
+
+// Version returns the version of the solver, which changes whenever its answers could.
+func (s *Solver) Version() string {
+	return "1"
+}
+
+// Parse reads the puzzle input.
+func (s *Solver) Parse(r io.Reader) error {
+	schematics, err := readLines(r)
+	if err != nil {
+		return err
+	}
+
+	s.schematics = schematics
+	return nil
+}
+
+// PartOne solves part one of the puzzle.
+func (s *Solver) PartOne(ctx context.Context) (solver.Answer, error) {
+	answer, err := partOne(ctx, s.schematics)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	return solver.IntAnswer(answer), nil
+}
+
+// PartTwo reports that there is no part two, as the final day only has one part.
+func (s *Solver) PartTwo(ctx context.Context) (solver.Answer, error) {
+	return solver.Answer{}, solver.ErrNoPart
+}
+
+// partOne solves part one of the puzzle.
+func partOne(ctx context.Context, schematics [][][]string) (int, error) {
+	locks := [][][]string{}
+	keys := [][][]string{}
+	for _, schematic := range schematics {
+		if schematic[0][0] == "#" {
+			locks = append(locks, schematic)
+		} else {
+			keys = append(keys, schematic)
+		}
+	}
+
+	allLockHeights := [][]int{}
+	for _, lock := range locks {
+		allLockHeights = append(allLockHeights, getLockHeights(lock))
+	}
+
+	allKeyHeights := [][]int{}
+	for _, key := range keys {
+		allKeyHeights = append(allKeyHeights, getKeyHeights(key))
+	}
+
+	total := 0
+	for _, lockHeights := range allLockHeights {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for _, keyHeights := range allKeyHeights {
+			if !overlap(lockHeights, keyHeights) {
+				total++
+			}
+		}
+	}
+	return total, nil
+}
+
+func getKeyHeights(key [][]string) []int {
+	heights := []int{}
+	for j := range key[0] {
+		for i := len(key) - 2; i >= 0; i-- {
+			if key[i][j] == "." {
+				heights = append(heights, len(key)-2-i)
+				break
+			}
+		}
+	}
+	return heights
+}
+
+func getLockHeights(key [][]string) []int {
+	heights := []int{}
+	for j := range key[0] {
+		for i := 1; i < len(key); i++ {
+			if key[i][j] == "." {
+				heights = append(heights, i-1)
+				break
+			}
+		}
+	}
+	return heights
+}
+
+func overlap(lockHeights, keyHeights []int) bool {
+	for i, height := range lockHeights {
+		if height+keyHeights[i] > 5 {
+			return true
+		}
+	}
+	return false
+}
+
+// readLines converts the information from the input into a usable form.
+func readLines(r io.Reader) ([][][]string, error) {
+	scanner := parse.NewScanner(r)
+
+	schematics := [][][]string{}
+	newSchematic := [][]string{}
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			if len(newSchematic) > 0 {
+				return nil, scanner.Line().Invalid("another row of the schematic")
+			}
+			continue
+		}
+		line := scanner.Line()
+		if err := line.OneOf("#."); err != nil {
+			return nil, err
+		}
+		if len(line.Text) != 5 {
+			return nil, line.Invalid("a row of 5 cells")
+		}
+		newSchematic = append(newSchematic, strings.Split(line.Text, ""))
+		if len(newSchematic) == 7 {
+			schematics = append(schematics, newSchematic)
+			newSchematic = [][]string{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(newSchematic) > 0 {
+		return nil, scanner.EndOfInput("another row of the schematic")
+	}
+
+	return schematics, nil
+}
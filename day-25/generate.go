@@ -0,0 +1,66 @@
+package day25
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generator generates random puzzle inputs for day 25.
+type Generator struct {
+	// Locks is the number of lock schematics.
+	Locks int `param:"locks"`
+	// Keys is the number of key schematics.
+	Keys int `param:"keys"`
+}
+
+// NewGenerator creates a generator of inputs the size of the real puzzle.
+func NewGenerator() *Generator {
+	return &Generator{Locks: 250, Keys: 250}
+}
+
+// Generate writes a random puzzle input of locks and keys in a random order, each with five pins of random heights
+// from 0 to 5.
+func (g *Generator) Generate(w io.Writer, rng *rand.Rand) error {
+	if g.Locks < 0 {
+		return fmt.Errorf("locks must not be negative, not %d", g.Locks)
+	}
+	if g.Keys < 0 {
+		return fmt.Errorf("keys must not be negative, not %d", g.Keys)
+	}
+
+	isLock := make([]bool, g.Locks+g.Keys)
+	for i := 0; i < g.Locks; i++ {
+		isLock[i] = true
+	}
+	rng.Shuffle(len(isLock), func(i, j int) { isLock[i], isLock[j] = isLock[j], isLock[i] })
+
+	schematics := []string{}
+	for _, lock := range isLock {
+		heights := [5]int{}
+		for i := range heights {
+			heights[i] = rng.IntN(6)
+		}
+		rows := []string{}
+		for row := 0; row < 7; row++ {
+			// A lock's pins hang from its top row and a key's rise from its bottom row.
+			cells := []byte{}
+			for _, height := range heights {
+				filledFrom, filledTo := 0, height
+				if !lock {
+					filledFrom, filledTo = 6-height, 6
+				}
+				if row >= filledFrom && row <= filledTo {
+					cells = append(cells, '#')
+				} else {
+					cells = append(cells, '.')
+				}
+			}
+			rows = append(rows, string(cells))
+		}
+		schematics = append(schematics, strings.Join(rows, "\n")+"\n")
+	}
+	_, err := io.WriteString(w, strings.Join(schematics, "\n"))
+	return err
+}
@@ -0,0 +1,154 @@
+// Package events lets the solvers report the steps of their algorithms, such as each location relaxed by a shortest
+// path search, so that they can be watched without editing the code. A Tracer travels with the context given to each
+// part, and receives nothing unless one was attached to the context.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Attr is a named value describing an event.
+type Attr struct {
+	Key   string
+	Value any
+}
+
+// Int returns an attribute holding an int.
+func Int(key string, value int) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// String returns an attribute holding a string.
+func String(key, value string) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Tracer receives the events of the solvers. Building the attributes of an event costs time even when they are
+// thrown away, so solvers check Enabled before calling Emit in their inner loops.
+type Tracer interface {
+	// Enabled reports whether the tracer records events.
+	Enabled() bool
+	// Emit records an event.
+	Emit(name string, attrs ...Attr)
+}
+
+// Discard is the tracer used when none is attached to a context. It records nothing.
+var Discard Tracer = discard{}
+
+// discard is a tracer that records nothing.
+type discard struct{}
+
+func (discard) Enabled() bool        { return false }
+func (discard) Emit(string, ...Attr) {}
+
+// contextKey is the key of the tracer in a context.
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries the tracer.
+func NewContext(ctx context.Context, t Tracer) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tracer carried by ctx, or Discard if it has none.
+func FromContext(ctx context.Context) Tracer {
+	if t, ok := ctx.Value(contextKey{}).(Tracer); ok {
+		return t
+	}
+	return Discard
+}
+
+// With returns a tracer that adds the given attributes before those of every event, such as the day and part that
+// emitted it.
+func With(t Tracer, attrs ...Attr) Tracer {
+	if !t.Enabled() {
+		return t
+	}
+	return with{tracer: t, attrs: attrs}
+}
+
+// with is a tracer that adds attributes to every event.
+type with struct {
+	tracer Tracer
+	attrs  []Attr
+}
+
+func (w with) Enabled() bool { return true }
+
+func (w with) Emit(name string, attrs ...Attr) {
+	w.tracer.Emit(name, append(w.attrs[:len(w.attrs):len(w.attrs)], attrs...)...)
+}
+
+// writer is the part shared by the tracers that write each event to a writer on its own line. It is safe for
+// concurrent use, so that parts solved at the same time do not mix up their lines. Write errors are ignored, as the
+// solvers could do nothing about them.
+type writer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	format func(name string, attrs []Attr) []byte
+}
+
+func (w *writer) Enabled() bool { return true }
+
+func (w *writer) Emit(name string, attrs ...Attr) {
+	line := w.format(name, attrs)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.w.Write(line)
+}
+
+// NewJSONLines returns a tracer that writes each event to w as a JSON object on its own line, with the name of the
+// event under "event" followed by its attributes in order, as in
+//
+//	{"event":"relax","day":16,"part":1,"row":13,"col":2,"points":1001}
+func NewJSONLines(w io.Writer) Tracer {
+	return &writer{w: w, format: func(name string, attrs []Attr) []byte {
+		var sb strings.Builder
+		sb.WriteString(`{"event":`)
+		sb.Write(encodeJSON(name))
+		for _, attr := range attrs {
+			sb.WriteByte(',')
+			sb.Write(encodeJSON(attr.Key))
+			sb.WriteByte(':')
+			sb.Write(encodeJSON(attr.Value))
+		}
+		sb.WriteString("}\n")
+		return []byte(sb.String())
+	}}
+}
+
+// encodeJSON encodes a value as JSON, or as a JSON string of its default format if it cannot be encoded.
+func encodeJSON(value any) []byte {
+	data, err := json.Marshal(value)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(value))
+	}
+	return data
+}
+
+// NewText returns a tracer that writes each event to w as a line of text for people to read, with the name of the
+// event followed by its attributes as key=value pairs, as in
+//
+//	relax day=16 part=1 row=13 col=2 points=1001
+//
+// Strings that are empty or contain spaces, quotes or equals signs are quoted.
+func NewText(w io.Writer) Tracer {
+	return &writer{w: w, format: func(name string, attrs []Attr) []byte {
+		var sb strings.Builder
+		sb.WriteString(name)
+		for _, attr := range attrs {
+			value := fmt.Sprint(attr.Value)
+			if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+				value = strconv.Quote(value)
+			}
+			fmt.Fprintf(&sb, " %s=%s", attr.Key, value)
+		}
+		sb.WriteByte('\n')
+		return []byte(sb.String())
+	}}
+}
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	if got := FromContext(context.Background()); got != Discard || got.Enabled() {
+		t.Errorf("got %v for a context without a tracer, want Discard", got)
+	}
+	var buffer bytes.Buffer
+	tracer := NewText(&buffer)
+	if got := FromContext(NewContext(context.Background(), tracer)); got != tracer {
+		t.Errorf("got %v, want the attached tracer", got)
+	}
+	if With(Discard, Int("day", 1)) != Discard {
+		t.Error("With enabled the discarding tracer")
+	}
+}
+
+func TestSinks(t *testing.T) {
+	tests := []struct {
+		name   string
+		tracer func(*bytes.Buffer) Tracer
+		want   string
+	}{
+		{
+			name:   "json",
+			tracer: func(b *bytes.Buffer) Tracer { return NewJSONLines(b) },
+			want: `{"event":"relax","day":16,"part":1,"row":13,"direction":"east"}` + "\n" +
+				`{"event":"recurse","day":16,"part":1,"clique":"co,de \"ka\""}` + "\n",
+		},
+		{
+			name:   "text",
+			tracer: func(b *bytes.Buffer) Tracer { return NewText(b) },
+			want: "relax day=16 part=1 row=13 direction=east\n" +
+				`recurse day=16 part=1 clique="co,de \"ka\""` + "\n",
+		},
+	}
+	for _, test := range tests {
+		var buffer bytes.Buffer
+		tracer := With(test.tracer(&buffer), Int("day", 16), Int("part", 1))
+		if !tracer.Enabled() {
+			t.Errorf("%s: tracer is not enabled", test.name)
+		}
+		tracer.Emit("relax", Int("row", 13), String("direction", "east"))
+		tracer.Emit("recurse", String("clique", `co,de "ka"`))
+		if got := buffer.String(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestConcurrentEmit(t *testing.T) {
+	var buffer bytes.Buffer
+	tracer := NewJSONLines(&buffer)
+	var wg sync.WaitGroup
+	for part := 1; part <= 2; part++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			partTracer := With(tracer, Int("part", part))
+			for step := range 100 {
+				partTracer.Emit("step", Int("step", step))
+			}
+		}()
+	}
+	wg.Wait()
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if len(lines) != 200 {
+		t.Fatalf("got %d lines, want 200", len(lines))
+	}
+	for _, line := range lines {
+		if !strings.HasPrefix(line, `{"event":"step","part":`) || !strings.HasSuffix(line, "}") {
+			t.Errorf("got mixed up line %q", line)
+		}
+	}
+}
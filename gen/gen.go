@@ -0,0 +1,44 @@
+// Package gen provides building blocks shared by the generators of random puzzle inputs.
+package gen
+
+import (
+	"math/rand/v2"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Maze creates a perfect maze: a grid of walls ('#') in which the positions at odd rows and columns are joined by
+// open passages ('.') so that there is exactly one path between any two of them. The width and height must be odd.
+func Maze(width, height int, rng *rand.Rand) *grid.Grid[byte] {
+	maze := grid.New[byte](width, height, '#')
+	start := grid.Point{Row: 1, Col: 1}
+	maze.Set(start, '.')
+	stack := []grid.Point{start}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		directions := []grid.Direction{}
+		for _, direction := range grid.Directions {
+			next := current.Add(direction.Offset().Scale(2))
+			if next.Row > 0 && next.Col > 0 && next.Row < height-1 && next.Col < width-1 && maze.At(next) == '#' {
+				directions = append(directions, direction)
+			}
+		}
+		if len(directions) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		direction := directions[rng.IntN(len(directions))]
+		next := current.Add(direction.Offset().Scale(2))
+		maze.Set(current.Move(direction), '.')
+		maze.Set(next, '.')
+		stack = append(stack, next)
+	}
+	return maze
+}
+
+// OddCell returns a random position at an odd row and column of a grid with the given odd width and height, which
+// is always open in a maze.
+func OddCell(width, height int, rng *rand.Rand) grid.Point {
+	return grid.Point{Row: 1 + 2*rng.IntN(height/2), Col: 1 + 2*rng.IntN(width/2)}
+}
@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+func TestMazeIsPerfect(t *testing.T) {
+	maze := Maze(21, 15, rand.New(rand.NewPCG(1, 2)))
+
+	// A perfect maze is a tree, so it has one fewer passage between cells than it has cells, and every cell can be
+	// reached from the first.
+	cells, passages := 0, 0
+	for position, cell := range maze.All() {
+		if cell != '.' {
+			continue
+		}
+		if position.Row%2 == 1 && position.Col%2 == 1 {
+			cells++
+		} else {
+			passages++
+		}
+	}
+	if cells != 10*7 || passages != cells-1 {
+		t.Errorf("got %d cells and %d passages, want 70 and 69", cells, passages)
+	}
+
+	seen := map[grid.Point]bool{{Row: 1, Col: 1}: true}
+	queue := []grid.Point{{Row: 1, Col: 1}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for next := range maze.Neighbours4(current) {
+			if maze.At(next) == '.' && !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	if len(seen) != cells+passages {
+		t.Errorf("reached %d of %d open positions", len(seen), cells+passages)
+	}
+}
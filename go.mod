@@ -1,3 +1,3 @@
 module github.com/markcooper37/aoc-2024
 
-go 1.21.4
+go 1.23
@@ -0,0 +1,68 @@
+package grid
+
+// Direction is one of the four orthogonal directions, numbered clockwise from north.
+type Direction int
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+)
+
+// Directions holds every direction, clockwise from north.
+var Directions = [4]Direction{North, East, South, West}
+
+// DirectionFromArrow converts an arrow character (^, >, v or <) to a direction.
+func DirectionFromArrow(arrow rune) (Direction, bool) {
+	switch arrow {
+	case '^':
+		return North, true
+	case '>':
+		return East, true
+	case 'v':
+		return South, true
+	case '<':
+		return West, true
+	}
+	return 0, false
+}
+
+// Right returns the direction after rotating 90 degrees clockwise.
+func (d Direction) Right() Direction {
+	return (d + 1) % 4
+}
+
+// Left returns the direction after rotating 90 degrees anticlockwise.
+func (d Direction) Left() Direction {
+	return (d + 3) % 4
+}
+
+// Reverse returns the opposite direction.
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+// Turns returns the number of 90 degree rotations needed to face another direction.
+func (d Direction) Turns(other Direction) int {
+	turns := (other - d + 4) % 4
+	if turns == 3 {
+		return 1
+	}
+	return int(turns)
+}
+
+// Offset returns the offset of a single step in the direction.
+func (d Direction) Offset() Point {
+	return Offsets4[d]
+}
+
+// Arrow returns the arrow character for the direction.
+func (d Direction) Arrow() rune {
+	return [4]rune{'^', '>', 'v', '<'}[d]
+}
+
+// String returns the name of the direction.
+func (d Direction) String() string {
+	return [4]string{"north", "east", "south", "west"}[d]
+}
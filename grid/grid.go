@@ -0,0 +1,235 @@
+// Package grid provides a rectangular grid of characters along with points and directions for moving around it.
+package grid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// Cell is the type of a single cell in a grid. Byte-backed grids suit ASCII puzzle maps, while rune-backed grids
+// hold any Unicode text.
+type Cell interface {
+	byte | rune
+}
+
+// Grid is a rectangular grid of cells, stored row by row in a single slice so that it is cheap to clone.
+type Grid[T Cell] struct {
+	width  int
+	height int
+	cells  []T
+}
+
+// New creates a grid of the given size with every cell set to fill.
+func New[T Cell](width, height int, fill T) *Grid[T] {
+	cells := make([]T, width*height)
+	for i := range cells {
+		cells[i] = fill
+	}
+	return &Grid[T]{width: width, height: height, cells: cells}
+}
+
+// FromLines creates a grid from lines of text, one row per line. Every line must have the same length.
+func FromLines[T Cell](lines []string) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return nil, errors.New("grid has no rows")
+	}
+	g := &Grid[T]{height: len(lines)}
+	for i, line := range lines {
+		row := toCells[T](line)
+		if i == 0 {
+			g.width = len(row)
+			g.cells = make([]T, 0, g.width*g.height)
+		} else if len(row) != g.width {
+			return nil, fmt.Errorf("row %d has length %d, expected %d", i+1, len(row), g.width)
+		}
+		g.cells = append(g.cells, row...)
+	}
+	return g, nil
+}
+
+// Parse reads a grid from r, one row per line, ignoring any trailing empty lines.
+func Parse[T Cell](r io.Reader) (*Grid[T], error) {
+	scanner := bufio.NewScanner(r)
+	lines := []string{}
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return FromLines[T](lines)
+}
+
+// toCells converts a line of text to cells.
+func toCells[T Cell](line string) []T {
+	cells := []T{}
+	var zero T
+	switch any(zero).(type) {
+	case byte:
+		for i := 0; i < len(line); i++ {
+			cells = append(cells, T(line[i]))
+		}
+	case rune:
+		for _, r := range line {
+			cells = append(cells, T(r))
+		}
+	}
+	return cells
+}
+
+// Width returns the number of columns in the grid.
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows in the grid.
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// InBounds checks if the point is within the boundaries of the grid.
+func (g *Grid[T]) InBounds(p Point) bool {
+	return p.Row >= 0 && p.Row < g.height && p.Col >= 0 && p.Col < g.width
+}
+
+// At returns the cell at a point, which must be within the boundaries of the grid.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[g.index(p)]
+}
+
+// Get returns the cell at a point and whether the point is within the boundaries of the grid.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.At(p), true
+}
+
+// Set sets the cell at a point, which must be within the boundaries of the grid.
+func (g *Grid[T]) Set(p Point, value T) {
+	g.cells[g.index(p)] = value
+}
+
+// index returns the position of a point in the cells slice.
+func (g *Grid[T]) index(p Point) int {
+	if !g.InBounds(p) {
+		panic(fmt.Sprintf("grid: point %v outside %dx%d grid", p, g.width, g.height))
+	}
+	return p.Row*g.width + p.Col
+}
+
+// Row returns a copy of a single row of the grid.
+func (g *Grid[T]) Row(row int) []T {
+	cells := make([]T, g.width)
+	copy(cells, g.cells[row*g.width:(row+1)*g.width])
+	return cells
+}
+
+// Find returns the first point, in reading order, holding the value.
+func (g *Grid[T]) Find(value T) (Point, bool) {
+	return g.FindFunc(func(cell T) bool { return cell == value })
+}
+
+// FindFunc returns the first point, in reading order, whose cell satisfies match.
+func (g *Grid[T]) FindFunc(match func(T) bool) (Point, bool) {
+	for p, cell := range g.All() {
+		if match(cell) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every point, in reading order, holding the value.
+func (g *Grid[T]) FindAll(value T) []Point {
+	points := []Point{}
+	for p, cell := range g.All() {
+		if cell == value {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// All yields every point in the grid along with its cell, in reading order.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, cell := range g.cells {
+			if !yield(Point{Row: i / g.width, Col: i % g.width}, cell) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours4 yields the orthogonal neighbours of a point that are within the boundaries of the grid.
+func (g *Grid[T]) Neighbours4(p Point) iter.Seq[Point] {
+	return g.inBounds(p.Neighbours4())
+}
+
+// Neighbours8 yields all neighbours of a point that are within the boundaries of the grid.
+func (g *Grid[T]) Neighbours8(p Point) iter.Seq[Point] {
+	return g.inBounds(p.Neighbours8())
+}
+
+// inBounds filters a sequence of points down to those within the boundaries of the grid.
+func (g *Grid[T]) inBounds(points iter.Seq[Point]) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for p := range points {
+			if g.InBounds(p) && !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// Clone creates a copy of the grid.
+func (g *Grid[T]) Clone() *Grid[T] {
+	cells := make([]T, len(g.cells))
+	copy(cells, g.cells)
+	return &Grid[T]{width: g.width, height: g.height, cells: cells}
+}
+
+// Equal checks whether two grids have the same size and cells.
+func (g *Grid[T]) Equal(other *Grid[T]) bool {
+	if g.width != other.width || g.height != other.height {
+		return false
+	}
+	for i, cell := range g.cells {
+		if cell != other.cells[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the grid in the same form that Parse reads, with each row followed by a newline.
+func (g *Grid[T]) String() string {
+	var sb strings.Builder
+	for i, cell := range g.cells {
+		switch value := any(cell).(type) {
+		case byte:
+			sb.WriteByte(value)
+		case rune:
+			sb.WriteRune(value)
+		}
+		if (i+1)%g.width == 0 {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+// WriteTo writes the grid to w in the same form that Parse reads.
+func (g *Grid[T]) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, g.String())
+	return int64(n), err
+}
@@ -0,0 +1,90 @@
+package grid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	input := "#.S\n.#.\nE..\n"
+	g, err := Parse[byte](strings.NewReader(input + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width() != 3 || g.Height() != 3 {
+		t.Fatalf("got %dx%d grid, want 3x3", g.Width(), g.Height())
+	}
+	if got := g.String(); got != input {
+		t.Errorf("got %q, want %q", got, input)
+	}
+
+	runes, err := Parse[rune](strings.NewReader("┌─┐\n└─┘\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runes.Width() != 3 || runes.At(Point{Row: 1, Col: 2}) != '┘' {
+		t.Errorf("rune grid parsed incorrectly: %q", runes.String())
+	}
+}
+
+func TestParseRejectsRaggedRows(t *testing.T) {
+	if _, err := Parse[byte](strings.NewReader("...\n..\n")); err == nil {
+		t.Error("expected an error for rows of different lengths")
+	}
+}
+
+func TestFindAndClone(t *testing.T) {
+	g, err := FromLines[byte]([]string{"a.b", "b.a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := g.Find('b'); !ok || p != (Point{Row: 0, Col: 2}) {
+		t.Errorf("Find returned %v, %v", p, ok)
+	}
+	if _, ok := g.Find('z'); ok {
+		t.Error("Find found a missing value")
+	}
+	want := []Point{{Row: 0, Col: 0}, {Row: 1, Col: 2}}
+	if got := g.FindAll('a'); !slices.Equal(got, want) {
+		t.Errorf("FindAll returned %v, want %v", got, want)
+	}
+
+	clone := g.Clone()
+	clone.Set(Point{Row: 0, Col: 1}, '#')
+	if g.At(Point{Row: 0, Col: 1}) != '.' {
+		t.Error("setting a cell in a clone changed the original")
+	}
+	if g.Equal(clone) {
+		t.Error("grids with different cells are equal")
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New(3, 3, byte('.'))
+	corner := slices.Collect(g.Neighbours4(Point{}))
+	if want := []Point{{Row: 0, Col: 1}, {Row: 1, Col: 0}}; !slices.Equal(corner, want) {
+		t.Errorf("got %v, want %v", corner, want)
+	}
+	if got := len(slices.Collect(g.Neighbours8(Point{Row: 1, Col: 1}))); got != 8 {
+		t.Errorf("centre has %d neighbours, want 8", got)
+	}
+	if got := len(slices.Collect(g.Neighbours8(Point{Row: 2, Col: 2}))); got != 3 {
+		t.Errorf("corner has %d neighbours, want 3", got)
+	}
+}
+
+func TestDirections(t *testing.T) {
+	for _, direction := range Directions {
+		if direction.Right().Left() != direction || direction.Right().Right() != direction.Reverse() {
+			t.Errorf("rotations of %v are inconsistent", direction)
+		}
+		arrow, ok := DirectionFromArrow(direction.Arrow())
+		if !ok || arrow != direction {
+			t.Errorf("arrow %c does not round trip", direction.Arrow())
+		}
+	}
+	if North.Turns(West) != 1 || North.Turns(South) != 2 || East.Turns(East) != 0 {
+		t.Error("Turns counted the wrong number of rotations")
+	}
+}
@@ -0,0 +1,70 @@
+package grid
+
+import "iter"
+
+// Point is a position in a grid, given as a row and a column.
+type Point struct {
+	Row int
+	Col int
+}
+
+// Offsets4 holds the offsets to the four orthogonal neighbours of a point, clockwise from north.
+var Offsets4 = [4]Point{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+
+// Offsets8 holds the offsets to all eight neighbours of a point, clockwise from north.
+var Offsets8 = [8]Point{{-1, 0}, {-1, 1}, {0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}}
+
+// Add returns the sum of two points.
+func (p Point) Add(q Point) Point {
+	return Point{Row: p.Row + q.Row, Col: p.Col + q.Col}
+}
+
+// Sub returns the difference between two points.
+func (p Point) Sub(q Point) Point {
+	return Point{Row: p.Row - q.Row, Col: p.Col - q.Col}
+}
+
+// Scale multiplies both coordinates of a point by a factor.
+func (p Point) Scale(factor int) Point {
+	return Point{Row: p.Row * factor, Col: p.Col * factor}
+}
+
+// Move returns the point one step away in the given direction.
+func (p Point) Move(direction Direction) Point {
+	return p.Add(direction.Offset())
+}
+
+// Manhattan returns the Manhattan distance between two points.
+func (p Point) Manhattan(q Point) int {
+	return abs(p.Row-q.Row) + abs(p.Col-q.Col)
+}
+
+// Neighbours4 yields the four orthogonal neighbours of a point, clockwise from north.
+func (p Point) Neighbours4() iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, offset := range Offsets4 {
+			if !yield(p.Add(offset)) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours8 yields all eight neighbours of a point, clockwise from north.
+func (p Point) Neighbours8() iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, offset := range Offsets8 {
+			if !yield(p.Add(offset)) {
+				return
+			}
+		}
+	}
+}
+
+// abs calculates the absolute value of an integer.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
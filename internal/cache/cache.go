@@ -0,0 +1,179 @@
+// Package cache stores the answers to the parts of the puzzles on disk, keyed by everything that can change them, so
+// that a part is only solved again once its input, parameters or solver change.
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Key identifies the answer to one part of a day.
+type Key struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+	Part int `json:"part"`
+	// InputHash is the hex-encoded SHA-256 hash of the input.
+	InputHash string `json:"inputHash"`
+	// Params holds the value of every parameter of the solver.
+	Params map[string]int `json:"params"`
+	BigInt bool           `json:"bigInt"`
+	// Version is the version of the solver, which changes whenever its answers could.
+	Version string `json:"version"`
+}
+
+// encode returns the canonical JSON encoding of the key, in which the parameters are sorted by name.
+func (k Key) encode() []byte {
+	if k.Params == nil {
+		k.Params = map[string]int{}
+	}
+	data, err := json.Marshal(k)
+	if err != nil {
+		panic(err) // a key always encodes
+	}
+	return data
+}
+
+// entry is the contents of a cache file.
+type entry struct {
+	Key    Key           `json:"key"`
+	Answer solver.Answer `json:"answer"`
+	// Checksum is the hex-encoded SHA-256 hash of the encoded key and answer, which shows up files that were damaged
+	// after they were written.
+	Checksum string `json:"checksum"`
+}
+
+// checksum returns the checksum of the entry's key and answer.
+func (e entry) checksum() string {
+	answer, err := json.Marshal(e.Answer)
+	if err != nil {
+		panic(err) // an answer always encodes
+	}
+	hash := sha256.Sum256(append(append(e.Key.encode(), '\n'), answer...))
+	return hex.EncodeToString(hash[:])
+}
+
+// tempSuffix is the suffix of the files that entries are written to before they are renamed into place.
+const tempSuffix = ".tmp"
+
+// staleTemp is how old a temporary file must be before Prune assumes that the writer crashed and removes it.
+const staleTemp = time.Minute
+
+// Cache is a cache of answers stored in a directory.
+type Cache struct {
+	dir string
+}
+
+// New returns a cache stored in the given directory, which is created when the first answer is stored.
+func New(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// path returns the name of the file holding the answer for a key.
+func (c *Cache) path(key Key) string {
+	hash := sha256.Sum256(key.encode())
+	return filepath.Join(c.dir, fmt.Sprint(key.Year), fmt.Sprintf("day-%02d", key.Day),
+		hex.EncodeToString(hash[:])+".json")
+}
+
+// Get returns the cached answer for a key, and whether there is one. A file that is missing, incomplete or damaged,
+// or that holds the answer for a different key, is treated as a miss.
+func (c *Cache) Get(key Key) (solver.Answer, bool) {
+	e, err := readEntry(c.path(key))
+	if err != nil || !bytes.Equal(e.Key.encode(), key.encode()) {
+		return solver.Answer{}, false
+	}
+	return e.Answer, true
+}
+
+// Put stores the answer for a key. The file is written in full under a temporary name and then renamed, so that a
+// reader never sees a partial file.
+func (c *Cache) Put(key Key, answer solver.Answer) error {
+	e := entry{Key: key, Answer: answer}
+	e.Checksum = e.checksum()
+	data, err := json.MarshalIndent(e, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	fileName := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(fileName), "*"+tempSuffix)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(data, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), fileName)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+	}
+	return err
+}
+
+// Prune removes the files that can no longer be used: entries that are damaged or whose key keep rejects, and
+// temporary files left behind by writers that stopped before finishing. It returns the number of files removed.
+func (c *Cache) Prune(keep func(Key) bool) (int, error) {
+	removed := 0
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && path == c.dir {
+			return fs.SkipAll
+		}
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		remove := false
+		if strings.HasSuffix(path, tempSuffix) {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			remove = time.Since(info.ModTime()) > staleTemp
+		} else {
+			e, err := readEntry(path)
+			remove = err != nil || path != c.path(e.Key) || !keep(e.Key)
+		}
+		if !remove {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	return removed, err
+}
+
+// readEntry reads a cache file, returning an error if it is incomplete or damaged.
+func readEntry(fileName string) (entry, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return entry{}, err
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return entry{}, fmt.Errorf("reading cache file %s: %w", fileName, err)
+	}
+	if e.Checksum != e.checksum() {
+		return entry{}, fmt.Errorf("cache file %s does not match its checksum", fileName)
+	}
+	return e, nil
+}
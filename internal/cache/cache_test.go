@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// key is the key of a cached answer in the tests.
+var key = Key{Year: 2024, Day: 18, Part: 2, InputHash: "abc", Params: map[string]int{"gridSize": 6}, Version: "1"}
+
+func TestGetPut(t *testing.T) {
+	c := New(filepath.Join(t.TempDir(), "results"))
+	if _, ok := c.Get(key); ok {
+		t.Error("got an answer from an empty cache")
+	}
+	if err := c.Put(key, solver.StringAnswer("6,1")); err != nil {
+		t.Fatal(err)
+	}
+	if answer, ok := c.Get(key); !ok || answer != solver.StringAnswer("6,1") {
+		t.Errorf("got %q, %t, want 6,1", answer, ok)
+	}
+
+	others := []Key{key, key, key, key}
+	others[0].Part = 1
+	others[1].Params = map[string]int{"gridSize": 70}
+	others[2].BigInt = true
+	others[3].Version = "2"
+	for _, other := range others {
+		if answer, ok := c.Get(other); ok {
+			t.Errorf("%+v: got %q for a different key", other, answer)
+		}
+	}
+}
+
+func TestDamagedFiles(t *testing.T) {
+	c := New(t.TempDir())
+	if err := c.Put(key, solver.IntAnswer(22)); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	damaged := map[string][]byte{
+		"truncated": data[:len(data)/2],
+		"empty":     {},
+		"edited":    []byte(strings.Replace(string(data), `"22"`, `"23"`, 1)),
+	}
+	for name, contents := range damaged {
+		if err := os.WriteFile(c.path(key), contents, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if answer, ok := c.Get(key); ok {
+			t.Errorf("%s: got %q from a damaged file", name, answer)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	c := New(t.TempDir())
+	stale := key
+	stale.Version = "0"
+	damaged := key
+	damaged.Part = 1
+	for _, k := range []Key{key, stale, damaged} {
+		if err := c.Put(k, solver.IntAnswer(1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(c.path(damaged), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Dir(c.path(key))
+	oldTemp, newTemp := filepath.Join(dir, "1"+tempSuffix), filepath.Join(dir, "2"+tempSuffix)
+	for _, fileName := range []string{oldTemp, newTemp} {
+		if err := os.WriteFile(fileName, []byte("{"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(oldTemp, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := c.Prune(func(k Key) bool { return k.Version == "1" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 3 {
+		t.Errorf("removed %d files, want 3", removed)
+	}
+	if _, ok := c.Get(key); !ok {
+		t.Error("pruned a current answer")
+	}
+	if _, err := os.Stat(newTemp); err != nil {
+		t.Errorf("pruned a temporary file that may still be being written: %v", err)
+	}
+
+	if removed, err := New(filepath.Join(t.TempDir(), "missing")).Prune(nil); removed != 0 || err != nil {
+		t.Errorf("missing directory: got %d, %v, want nothing removed", removed, err)
+	}
+}
@@ -0,0 +1,192 @@
+// Package client downloads puzzle inputs from the Advent of Code site, keeping a local cache so that each input is
+// only ever downloaded once. Inputs differ by user, so the cache keeps the inputs of each site and account apart.
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the address of the Advent of Code site.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// DefaultMinInterval is the default minimum time between requests to the site.
+const DefaultMinInterval = 5 * time.Second
+
+// userAgent identifies the client to the site, as its maintainers ask automated tools to do.
+const userAgent = "github.com/markcooper37/aoc-2024"
+
+// ErrNoSession is returned when a request needs a session token and none has been given.
+var ErrNoSession = errors.New("no session token")
+
+// Client requests puzzle inputs from the site on behalf of a logged in user.
+type Client struct {
+	// BaseURL is the address of the site, without a trailing slash.
+	BaseURL string
+	// Session is the value of the session cookie of a logged in user.
+	Session string
+	// CacheDir is the directory that downloaded inputs are kept in.
+	CacheDir string
+	// MinInterval is the minimum time between requests to the site, including those made by other processes
+	// sharing the cache directory.
+	MinInterval time.Duration
+	// HTTPClient makes the requests.
+	HTTPClient *http.Client
+}
+
+// New creates a client for the site at baseURL that caches inputs in cacheDir.
+func New(baseURL, session, cacheDir string) *Client {
+	return &Client{
+		BaseURL:     strings.TrimSuffix(baseURL, "/"),
+		Session:     session,
+		CacheDir:    cacheDir,
+		MinInterval: DefaultMinInterval,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Input returns the puzzle input for a day, downloading it only if it is not already cached for the site and session.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	cachePath := c.inputPath(year, day)
+	if input, err := os.ReadFile(cachePath); err == nil {
+		return input, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	input, err := c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(cachePath, input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// Cached reports whether the puzzle input for a day is in the cache for the site and session.
+func (c *Client) Cached(year, day int) bool {
+	_, err := os.Stat(c.inputPath(year, day))
+	return err == nil
+}
+
+// inputPath returns the path of the cached puzzle input for a day. It is under a directory for the host of the site,
+// and within that one for the account, named by the start of the SHA-256 hash of the session token so that the token
+// itself is not written to disk. Without a session token, there is no account whose inputs could be used.
+func (c *Client) inputPath(year, day int) string {
+	host := c.BaseURL
+	if u, err := url.Parse(c.BaseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	account := "no-session"
+	if c.Session != "" {
+		hash := sha256.Sum256([]byte(c.Session))
+		account = hex.EncodeToString(hash[:8])
+	}
+	return filepath.Join(c.CacheDir, strings.NewReplacer(":", "_", "/", "_").Replace(host), account, fmt.Sprint(year),
+		fmt.Sprintf("day-%02d", day), "input.txt")
+}
+
+// get requests a path from the site and returns the body of a successful response.
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	request, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(request)
+}
+
+// newRequest creates a request for a path on the site, carrying the session cookie.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	request.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	request.Header.Set("User-Agent", userAgent)
+	return request, nil
+}
+
+// do sends a request once the minimum interval since the last request has passed, and returns the body of a
+// successful response.
+func (c *Client) do(request *http.Request) ([]byte, error) {
+	if err := c.wait(request.Context()); err != nil {
+		return nil, err
+	}
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: %s: %s", request.Method, request.URL.Path, response.Status, summary(body))
+	}
+	return body, nil
+}
+
+// wait blocks until the minimum interval has passed since the last request made with the cache directory, then
+// records the time of the new request.
+func (c *Client) wait(ctx context.Context) error {
+	stampPath := filepath.Join(c.CacheDir, "last-request")
+	if info, err := os.Stat(stampPath); err == nil {
+		if delay := c.MinInterval - time.Since(info.ModTime()); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	return writeFile(stampPath, nil)
+}
+
+// summary returns the first line of a response body, for including in an error.
+func summary(body []byte) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(string(body)), "\n")
+	if len(line) > 200 {
+		line = line[:200] + "..."
+	}
+	return line
+}
+
+// writeFile writes data to a file, creating its directory if necessary. The data is written to a temporary file
+// first so that an interrupted write never leaves a partial file behind.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newServer starts a stand-in for the site that serves an input for every day of 2024, counting the requests it
+// receives.
+func newServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2024/day/{day}/input", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, "input for day %s\n", r.PathValue("day"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestInputIsCached(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+	c := New(server.URL, "secret", t.TempDir())
+	c.MinInterval = 0
+
+	for i := 0; i < 2; i++ {
+		input, err := c.Input(context.Background(), 2024, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(input) != "input for day 5\n" {
+			t.Errorf("got input %q", input)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("made %d requests, want 1", n)
+	}
+	if !c.Cached(2024, 5) || c.Cached(2024, 6) {
+		t.Error("Cached does not match the downloaded inputs")
+	}
+
+	// A cached input is only used for the site and account it was downloaded for.
+	c.Session = ""
+	if _, err := c.Input(context.Background(), 2024, 5); !errors.Is(err, ErrNoSession) {
+		t.Errorf("no session: got error %v, want %v", err, ErrNoSession)
+	}
+	c.Session = "another"
+	if _, err := c.Input(context.Background(), 2024, 5); err == nil {
+		t.Error("another account was given the cached input")
+	}
+	var otherRequests atomic.Int32
+	other := New(newServer(t, &otherRequests).URL, "secret", c.CacheDir)
+	other.MinInterval = 0
+	if _, err := other.Input(context.Background(), 2024, 5); err != nil {
+		t.Fatal(err)
+	}
+	if n := otherRequests.Load(); n != 1 {
+		t.Errorf("made %d requests to another site, want 1", n)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+	cacheDir := t.TempDir()
+
+	_, err := New(server.URL, "", cacheDir).Input(context.Background(), 2024, 1)
+	if !errors.Is(err, ErrNoSession) {
+		t.Errorf("got error %v, want %v", err, ErrNoSession)
+	}
+
+	c := New(server.URL, "wrong", cacheDir)
+	c.MinInterval = 0
+	_, err = c.Input(context.Background(), 2024, 1)
+	if err == nil || !strings.Contains(err.Error(), "400 Bad Request: Puzzle inputs differ by user") {
+		t.Errorf("got error %v, want the response status and message", err)
+	}
+	if c.Cached(2024, 1) {
+		t.Error("a failed download was cached")
+	}
+
+	_, err = c.Input(context.Background(), 2023, 1)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("got error %v, want a 404", err)
+	}
+}
+
+func TestMinInterval(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+	cacheDir := t.TempDir()
+
+	first := New(server.URL, "secret", cacheDir)
+	first.MinInterval = 100 * time.Millisecond
+	if _, err := first.Input(context.Background(), 2024, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second client sharing the cache directory stands in for a later run of the command.
+	second := New(server.URL, "secret", cacheDir)
+	second.MinInterval = 100 * time.Millisecond
+	start := time.Now()
+	if _, err := second.Input(context.Background(), 2024, 2); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("second request was made after %v, want it delayed by the minimum interval", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := second.Input(ctx, 2024, 3); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if _, err := os.Stat(second.inputPath(2024, 3)); err == nil {
+		t.Error("a cancelled download was cached")
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /2024/day/{day}/answer", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("level") != "1" || r.FormValue("answer") != "42" {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `<html><main><article><p>That's the right answer!  You are <em>one gold star</em> closer.</p></article></main></html>`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := New(server.URL, "secret", t.TempDir())
+	c.MinInterval = 0
+	result, err := c.Submit(context.Background(), 2024, 3, 1, "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != Correct || result.Message != "That's the right answer! You are one gold star closer." {
+		t.Errorf("got %+v", result)
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		body    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{"<article><p>That's the right answer!</p></article>", Correct, 0},
+		{"<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.</p></article>", Wrong, time.Minute},
+		{"<article><p>That's not the right answer; your answer is too high.  Please wait 5 minutes before trying again.</p></article>", TooHigh, 5 * time.Minute},
+		{"<article><p>That's not the right answer; your answer is too low.</p></article>", TooLow, 0},
+		{"<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 37s left to wait.</p></article>", RateLimited, 4*time.Minute + 37*time.Second},
+		{"<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>", AlreadySolved, 0},
+		{"<html>Something else</html>", Unknown, 0},
+	}
+	for _, test := range tests {
+		result := ParseResponse([]byte(test.body))
+		if result.Verdict != test.verdict || result.Wait != test.wait {
+			t.Errorf("%q: got %v with wait %v, want %v with wait %v", test.body, result.Verdict, result.Wait,
+				test.verdict, test.wait)
+		}
+	}
+}
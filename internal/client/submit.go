@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the site's judgement of a submitted answer.
+type Verdict int
+
+const (
+	// Unknown means the response could not be understood.
+	Unknown Verdict = iota
+	Correct
+	Wrong
+	TooHigh
+	TooLow
+	// RateLimited means the answer was not checked because another answer was submitted too recently.
+	RateLimited
+	// AlreadySolved means the part has already been solved, so the answer was not checked.
+	AlreadySolved
+)
+
+var verdictNames = []string{"unknown", "correct", "wrong", "too high", "too low", "rate limited", "already solved"}
+
+// String returns the name of a verdict.
+func (v Verdict) String() string {
+	if v < 0 || int(v) >= len(verdictNames) {
+		return fmt.Sprintf("Verdict(%d)", int(v))
+	}
+	return verdictNames[v]
+}
+
+// MarshalText encodes a verdict as its name.
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText decodes a verdict from its name.
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for i, name := range verdictNames {
+		if name == string(text) {
+			*v = Verdict(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict %q", text)
+}
+
+// Incorrect reports whether the verdict means that the answer was checked and found to be wrong.
+func (v Verdict) Incorrect() bool {
+	return v == Wrong || v == TooHigh || v == TooLow
+}
+
+// Result is the site's response to a submitted answer.
+type Result struct {
+	Verdict Verdict
+	// Wait is how long the site asks for before the next answer is submitted, if it says.
+	Wait time.Duration
+	// Message is the text of the response.
+	Message string
+}
+
+// Submit submits an answer to one part of a day's puzzle.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Result, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	request, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return Result{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(request)
+	if err != nil {
+		return Result{}, err
+	}
+	return ParseResponse(body), nil
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	spacePattern   = regexp.MustCompile(`\s+`)
+	waitPattern    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	retryPattern   = regexp.MustCompile(`[Pp]lease wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseResponse works out the verdict from the page the site returns after an answer is submitted.
+func ParseResponse(body []byte) Result {
+	text := string(body)
+	if match := articlePattern.FindStringSubmatch(text); match != nil {
+		text = match[1]
+	}
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+	result := Result{Message: strings.TrimSpace(spacePattern.ReplaceAllString(text, " "))}
+
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		result.Verdict = Correct
+	case strings.Contains(text, "You gave an answer too recently"):
+		result.Verdict = RateLimited
+	case strings.Contains(text, "Did you already complete it"):
+		result.Verdict = AlreadySolved
+	case strings.Contains(text, "your answer is too high"):
+		result.Verdict = TooHigh
+	case strings.Contains(text, "your answer is too low"):
+		result.Verdict = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		result.Verdict = Wrong
+	}
+
+	if match := waitPattern.FindStringSubmatch(text); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := retryPattern.FindStringSubmatch(text); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		result.Wait = time.Duration(minutes) * time.Minute
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+// Package ledger records every answer submitted to the site, so that answers already known to be wrong, or ruled
+// out by an earlier "too high" or "too low" verdict, are rejected before they are sent.
+package ledger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/internal/client"
+)
+
+// ErrRejected is returned by Check for answers that should not be submitted.
+var ErrRejected = errors.New("answer rejected")
+
+// Attempt is a single submission of an answer.
+type Attempt struct {
+	Year    int            `json:"year"`
+	Day     int            `json:"day"`
+	Part    int            `json:"part"`
+	Answer  string         `json:"answer"`
+	Verdict client.Verdict `json:"verdict"`
+	Time    time.Time      `json:"time"`
+	// RetryAfter is when the site allows the next submission, if it asked for a wait.
+	RetryAfter *time.Time `json:"retryAfter,omitempty"`
+}
+
+// Ledger is the list of attempts stored in a file.
+type Ledger struct {
+	fileName string
+	Attempts []Attempt
+}
+
+// Open reads the ledger stored in the named file, or starts an empty one if the file does not exist.
+func Open(fileName string) (*Ledger, error) {
+	l := &Ledger{fileName: fileName, Attempts: []Attempt{}}
+	data, err := os.ReadFile(fileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &l.Attempts); err != nil {
+		return nil, fmt.Errorf("reading ledger %s: %w", fileName, err)
+	}
+	return l, nil
+}
+
+// Check returns an error wrapping ErrRejected if the answer is already known to be wrong, if the part has already
+// been solved, or if the site asked for a wait that has not yet passed.
+func (l *Ledger) Check(year, day, part int, answer string, now time.Time) error {
+	value, numeric := parseInt(answer)
+	for _, attempt := range l.Attempts {
+		if attempt.RetryAfter != nil && now.Before(*attempt.RetryAfter) {
+			return fmt.Errorf("%w: the site asked for no answers until %s, %s from now", ErrRejected,
+				attempt.RetryAfter.Format(time.TimeOnly), attempt.RetryAfter.Sub(now).Round(time.Second))
+		}
+		if attempt.Year != year || attempt.Day != day || attempt.Part != part {
+			continue
+		}
+
+		switch {
+		case attempt.Verdict == client.Correct && attempt.Answer == answer:
+			return fmt.Errorf("%w: %s is already known to be correct", ErrRejected, answer)
+		case attempt.Verdict == client.Correct:
+			return fmt.Errorf("%w: the part was already solved with %s", ErrRejected, attempt.Answer)
+		case attempt.Verdict.Incorrect() && attempt.Answer == answer:
+			return fmt.Errorf("%w: %s was already submitted and was %s", ErrRejected, answer, attempt.Verdict)
+		}
+
+		previous, ok := parseInt(attempt.Answer)
+		if !numeric || !ok {
+			continue
+		}
+		if attempt.Verdict == client.TooHigh && value >= previous {
+			return fmt.Errorf("%w: %s is not below %s, which was too high", ErrRejected, answer, attempt.Answer)
+		}
+		if attempt.Verdict == client.TooLow && value <= previous {
+			return fmt.Errorf("%w: %s is not above %s, which was too low", ErrRejected, answer, attempt.Answer)
+		}
+	}
+	return nil
+}
+
+// Record adds an attempt to the ledger and saves it.
+func (l *Ledger) Record(attempt Attempt) error {
+	l.Attempts = append(l.Attempts, attempt)
+	data, err := json.MarshalIndent(l.Attempts, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(l.fileName), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(l.fileName, append(data, '\n'), 0o644)
+}
+
+// parseInt parses an answer as an integer, reporting whether it is one.
+func parseInt(answer string) (int, bool) {
+	value, err := strconv.Atoi(answer)
+	return value, err == nil
+}
@@ -0,0 +1,76 @@
+package ledger
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/markcooper37/aoc-2024/internal/client"
+)
+
+func TestCheck(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "ledger.json")
+	l, err := Open(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 12, 5, 6, 0, 0, 0, time.UTC)
+	for _, attempt := range []Attempt{
+		{Year: 2024, Day: 5, Part: 1, Answer: "500", Verdict: client.TooHigh, Time: now},
+		{Year: 2024, Day: 5, Part: 1, Answer: "100", Verdict: client.TooLow, Time: now},
+		{Year: 2024, Day: 5, Part: 1, Answer: "250", Verdict: client.Wrong, Time: now},
+		{Year: 2024, Day: 4, Part: 1, Answer: "18", Verdict: client.Correct, Time: now},
+	} {
+		if err := l.Record(attempt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Reopen the ledger to check that the attempts were saved.
+	if l, err = Open(fileName); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		day      int
+		answer   string
+		rejected bool
+	}{
+		{5, "500", true},
+		{5, "600", true},
+		{5, "100", true},
+		{5, "99", true},
+		{5, "250", true},
+		{5, "251", false},
+		{5, "abc", false},
+		{4, "18", true},
+		{4, "19", true},
+		{6, "500", false},
+	}
+	for _, test := range tests {
+		err := l.Check(2024, test.day, 1, test.answer, now)
+		if rejected := errors.Is(err, ErrRejected); rejected != test.rejected {
+			t.Errorf("day %d answer %s: got error %v, want rejected %v", test.day, test.answer, err, test.rejected)
+		}
+	}
+}
+
+func TestCheckRetryAfter(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "ledger.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 12, 5, 6, 0, 0, 0, time.UTC)
+	retryAfter := now.Add(time.Minute)
+	if err := l.Record(Attempt{Year: 2024, Day: 5, Part: 1, Answer: "1", Verdict: client.RateLimited, Time: now,
+		RetryAfter: &retryAfter}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.Check(2024, 6, 2, "7", now.Add(30*time.Second)); !errors.Is(err, ErrRejected) {
+		t.Errorf("got error %v during the wait, want it rejected", err)
+	}
+	if err := l.Check(2024, 6, 2, "7", now.Add(2*time.Minute)); err != nil {
+		t.Errorf("got error %v after the wait", err)
+	}
+}
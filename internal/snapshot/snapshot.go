@@ -0,0 +1,91 @@
+// Package snapshot records the answer to each part of the puzzles for each input, so that a change that alters an
+// answer is noticed.
+package snapshot
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+)
+
+// Entry is the recorded answer to one part of a day for one input.
+type Entry struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+	Part int `json:"part"`
+	// InputHash is the hex-encoded SHA-256 hash of the input.
+	InputHash string `json:"inputHash"`
+	// Params holds the parameters that differ from the day's defaults, so that answers for other parameters are
+	// recorded separately.
+	Params map[string]int `json:"params,omitempty"`
+	Answer string         `json:"answer"`
+}
+
+// sameKey reports whether two entries record the answer to the same part for the same input and parameters.
+func sameKey(a, b Entry) bool {
+	return a.Year == b.Year && a.Day == b.Day && a.Part == b.Part && a.InputHash == b.InputHash &&
+		maps.Equal(a.Params, b.Params)
+}
+
+// Snapshot is the list of recorded answers stored in a file.
+type Snapshot struct {
+	fileName string
+	Entries  []Entry
+}
+
+// Open reads the snapshot stored in the named file. If the file does not exist, Open starts an empty snapshot when
+// create is set, to record answers in, and otherwise returns an error.
+func Open(fileName string, create bool) (*Snapshot, error) {
+	s := &Snapshot{fileName: fileName, Entries: []Entry{}}
+	data, err := os.ReadFile(fileName)
+	if errors.Is(err, os.ErrNotExist) && create {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &s.Entries); err != nil {
+		return nil, fmt.Errorf("reading snapshot %s: %w", fileName, err)
+	}
+	return s, nil
+}
+
+// Lookup returns the recorded answer to a part of a day for the input with the given hash and the given parameters,
+// and whether there is one.
+func (s *Snapshot) Lookup(year, day, part int, inputHash string, params map[string]int) (string, bool) {
+	key := Entry{Year: year, Day: day, Part: part, InputHash: inputHash, Params: params}
+	for _, entry := range s.Entries {
+		if sameKey(entry, key) {
+			return entry.Answer, true
+		}
+	}
+	return "", false
+}
+
+// Set records an answer, replacing any earlier answer to the same part for the same input and parameters.
+func (s *Snapshot) Set(entry Entry) {
+	for i, existing := range s.Entries {
+		if sameKey(existing, entry) {
+			s.Entries[i] = entry
+			return
+		}
+	}
+	s.Entries = append(s.Entries, entry)
+}
+
+// Save writes the snapshot to its file, sorted so that recording the same answers always gives the same file.
+func (s *Snapshot) Save() error {
+	slices.SortFunc(s.Entries, func(a, b Entry) int {
+		return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part),
+			cmp.Compare(a.InputHash, b.InputHash), cmp.Compare(fmt.Sprint(a.Params), fmt.Sprint(b.Params)))
+	})
+	data, err := json.MarshalIndent(s.Entries, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.fileName, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,78 @@
+package snapshot
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "answers.json")
+	if _, err := Open(fileName, false); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("got error %v for a missing snapshot, want %v", err, os.ErrNotExist)
+	}
+	s, err := Open(fileName, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range []Entry{
+		{Year: 2024, Day: 5, Part: 2, InputHash: "b", Answer: "123"},
+		{Year: 2024, Day: 5, Part: 1, InputHash: "b", Params: map[string]int{"size": 3}, Answer: "7"},
+		{Year: 2024, Day: 5, Part: 1, InputHash: "b", Answer: "143"},
+		{Year: 2024, Day: 5, Part: 1, InputHash: "a", Answer: "99"},
+		{Year: 2024, Day: 5, Part: 2, InputHash: "b", Answer: "124"},
+	} {
+		s.Set(entry)
+	}
+	if err := s.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the snapshot to check that the answers were saved in order.
+	if s, err = Open(fileName, false); err != nil {
+		t.Fatal(err)
+	}
+	want := []Entry{
+		{Year: 2024, Day: 5, Part: 1, InputHash: "a", Answer: "99"},
+		{Year: 2024, Day: 5, Part: 1, InputHash: "b", Answer: "143"},
+		{Year: 2024, Day: 5, Part: 1, InputHash: "b", Params: map[string]int{"size": 3}, Answer: "7"},
+		{Year: 2024, Day: 5, Part: 2, InputHash: "b", Answer: "124"},
+	}
+	if !slices.EqualFunc(s.Entries, want, func(a, b Entry) bool { return sameKey(a, b) && a.Answer == b.Answer }) {
+		t.Errorf("got entries %v, want %v", s.Entries, want)
+	}
+
+	tests := []struct {
+		day, part int
+		inputHash string
+		params    map[string]int
+		answer    string
+		ok        bool
+	}{
+		{5, 1, "a", nil, "99", true},
+		{5, 1, "b", nil, "143", true},
+		{5, 1, "b", map[string]int{"size": 3}, "7", true},
+		{5, 1, "b", map[string]int{"size": 4}, "", false},
+		{5, 2, "a", nil, "", false},
+		{6, 1, "a", nil, "", false},
+	}
+	for _, test := range tests {
+		answer, ok := s.Lookup(2024, test.day, test.part, test.inputHash, test.params)
+		if answer != test.answer || ok != test.ok {
+			t.Errorf("day %d part %d input %s params %v: got %q, %t, want %q, %t", test.day, test.part,
+				test.inputHash, test.params, answer, ok, test.answer, test.ok)
+		}
+	}
+}
+
+func TestOpenInvalid(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "answers.json")
+	if err := os.WriteFile(fileName, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(fileName, true); err == nil {
+		t.Error("opened a snapshot that is not valid JSON")
+	}
+}
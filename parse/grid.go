@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/markcooper37/aoc-2024/grid"
+)
+
+// Grid reads a byte grid from the scanner, one row per line, stopping at an empty line or the end of the input. Every
+// cell must be one of the allowed characters, unless allowed is empty.
+func Grid(s *Scanner, allowed string) (*grid.Grid[byte], error) {
+	lines, err := gridLines(s, allowed)
+	if err != nil {
+		return nil, err
+	}
+	return fromLines(lines)
+}
+
+// WalledGrid reads a byte grid as Grid does, and also checks that every cell around its edge is a wall, so that
+// walks through the grid that stop at walls never leave it.
+func WalledGrid(s *Scanner, allowed string, wall byte) (*grid.Grid[byte], error) {
+	lines, err := gridLines(s, allowed)
+	if err != nil {
+		return nil, err
+	}
+	expected := fmt.Sprintf("%q all around the edge of the grid", wall)
+	for row, line := range lines {
+		for col := range len(line.Text) {
+			edge := row == 0 || row == len(lines)-1 || col == 0 || col == len(line.Text)-1
+			if edge && line.Text[col] != wall {
+				return nil, line.Slice(col, col+1).Invalid(expected)
+			}
+		}
+	}
+	return fromLines(lines)
+}
+
+// gridLines reads the rows of a grid, checking that they have the same length and hold only allowed characters.
+func gridLines(s *Scanner, allowed string) ([]Line, error) {
+	lines := []Line{}
+	for s.Scan() && s.Text() != "" {
+		line := s.Line()
+		if allowed != "" {
+			if err := line.OneOf(allowed); err != nil {
+				return nil, err
+			}
+		}
+		if len(lines) > 0 && len(line.Text) != len(lines[0].Text) {
+			return nil, line.Invalid(fmt.Sprintf("a row of length %d", len(lines[0].Text)))
+		}
+		lines = append(lines, line)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, s.EndOfInput("a grid")
+	}
+	return lines, nil
+}
+
+// fromLines builds a grid from its rows.
+func fromLines(lines []Line) (*grid.Grid[byte], error) {
+	rows := make([]string, len(lines))
+	for i, line := range lines {
+		rows[i] = line.Text
+	}
+	return grid.FromLines[byte](rows)
+}
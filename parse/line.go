@@ -0,0 +1,150 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Line is a line of input, or part of one, along with its position.
+type Line struct {
+	File   string
+	Number int
+	Column int // column of the first character of Text, starting from 1
+	Text   string
+}
+
+// Invalid returns an error reporting that the line does not have the expected shape.
+func (l Line) Invalid(expected string) error {
+	return &Error{File: l.File, Line: l.Number, Column: l.Column, Text: l.Text, Expected: expected}
+}
+
+// Slice returns the part of the line between the given byte offsets.
+func (l Line) Slice(start, end int) Line {
+	l.Column += start
+	l.Text = l.Text[start:end]
+	return l
+}
+
+// Cut splits the line around the first instance of sep, returning an error describing the expected shape if sep does
+// not appear.
+func (l Line) Cut(sep, expected string) (Line, Line, error) {
+	i := strings.Index(l.Text, sep)
+	if i < 0 {
+		return Line{}, Line{}, l.Invalid(expected)
+	}
+	return l.Slice(0, i), l.Slice(i+len(sep), len(l.Text)), nil
+}
+
+// Split splits the line into the parts separated by sep.
+func (l Line) Split(sep string) []Line {
+	parts := []Line{}
+	for {
+		before, after, err := l.Cut(sep, "")
+		if err != nil {
+			return append(parts, l)
+		}
+		parts = append(parts, before)
+		l = after
+	}
+}
+
+// SplitN splits the line into exactly n parts separated by sep, returning an error describing the expected shape if
+// there is a different number of parts.
+func (l Line) SplitN(sep string, n int, expected string) ([]Line, error) {
+	parts := l.Split(sep)
+	if len(parts) != n {
+		return nil, l.Invalid(expected)
+	}
+	return parts, nil
+}
+
+// Fields splits the line into the parts separated by runs of spaces.
+func (l Line) Fields() []Line {
+	fields := []Line{}
+	start := -1
+	for i := 0; i <= len(l.Text); i++ {
+		if i < len(l.Text) && l.Text[i] != ' ' {
+			if start < 0 {
+				start = i
+			}
+		} else if start >= 0 {
+			fields = append(fields, l.Slice(start, i))
+			start = -1
+		}
+	}
+	return fields
+}
+
+// TrimPrefix removes prefix from the start of the line, returning an error if the line does not start with it.
+func (l Line) TrimPrefix(prefix string) (Line, error) {
+	if !strings.HasPrefix(l.Text, prefix) {
+		return Line{}, l.Invalid(fmt.Sprintf("text starting with %q", prefix))
+	}
+	return l.Slice(len(prefix), len(l.Text)), nil
+}
+
+// TrimSuffix removes suffix from the end of the line, returning an error if the line does not end with it.
+func (l Line) TrimSuffix(suffix string) (Line, error) {
+	if !strings.HasSuffix(l.Text, suffix) {
+		return Line{}, l.Invalid(fmt.Sprintf("text ending with %q", suffix))
+	}
+	return l.Slice(0, len(l.Text)-len(suffix)), nil
+}
+
+// Int parses the line as a decimal integer.
+func (l Line) Int() (int, error) {
+	value, err := strconv.Atoi(l.Text)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, l.Invalid("an integer that fits in 64 bits")
+	} else if err != nil {
+		return 0, l.Invalid("an integer")
+	}
+	return value, nil
+}
+
+// Ints parses the parts of the line separated by sep as decimal integers.
+func (l Line) Ints(sep string) ([]int, error) {
+	return ints(l.Split(sep))
+}
+
+// IntFields parses the parts of the line separated by runs of spaces as decimal integers.
+func (l Line) IntFields() ([]int, error) {
+	return ints(l.Fields())
+}
+
+// Digits parses each character of the line as a decimal digit.
+func (l Line) Digits() ([]int, error) {
+	digits := make([]int, len(l.Text))
+	for i := range l.Text {
+		if l.Text[i] < '0' || l.Text[i] > '9' {
+			return nil, l.Slice(i, i+1).Invalid("a digit")
+		}
+		digits[i] = int(l.Text[i] - '0')
+	}
+	return digits, nil
+}
+
+// OneOf checks that every character of the line is one of the allowed characters.
+func (l Line) OneOf(allowed string) error {
+	for i, char := range l.Text {
+		if !strings.ContainsRune(allowed, char) {
+			return l.Slice(i, i+len(string(char))).Invalid(fmt.Sprintf("one of %q", allowed))
+		}
+	}
+	return nil
+}
+
+// ints parses each of the lines as a decimal integer.
+func ints(lines []Line) ([]int, error) {
+	values := make([]int, len(lines))
+	for i, line := range lines {
+		value, err := line.Int()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
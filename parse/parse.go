@@ -0,0 +1,125 @@
+// Package parse reads puzzle input line by line and reports malformed input as errors that give the position of
+// the problem, the offending text and the shape of input that was expected.
+package parse
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Error describes input that does not have the expected shape.
+type Error struct {
+	File     string // name of the input file, or empty if it is not known
+	Line     int    // line number, starting from 1
+	Column   int    // column of the first offending character, starting from 1
+	Text     string // the offending text, or empty if the input ended early
+	Expected string // the shape of input that was expected
+}
+
+// Error returns the position of the problem followed by a description of it.
+func (e *Error) Error() string {
+	file := e.File
+	if file == "" {
+		file = "input"
+	}
+	position := fmt.Sprintf("%s:%d:%d", file, e.Line, e.Column)
+	if e.Text == "" {
+		return fmt.Sprintf("%s: expected %s", position, e.Expected)
+	}
+	return fmt.Sprintf("%s: unexpected %q, expected %s", position, e.Text, e.Expected)
+}
+
+// Scanner reads input one line at a time, keeping track of the position for error reporting.
+type Scanner struct {
+	scanner *bufio.Scanner
+	file    string
+	line    int
+	text    string
+}
+
+// NewScanner creates a scanner reading from r. If r is a file, or was created by NamedReader, its name is included
+// in any errors.
+func NewScanner(r io.Reader) *Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	s := &Scanner{scanner: scanner}
+	if named, ok := r.(interface{ Name() string }); ok {
+		s.file = named.Name()
+	}
+	return s
+}
+
+// Scan advances to the next line, returning false at the end of the input or if reading fails.
+func (s *Scanner) Scan() bool {
+	if !s.scanner.Scan() {
+		return false
+	}
+	s.line++
+	s.text = s.scanner.Text()
+	return true
+}
+
+// Text returns the current line.
+func (s *Scanner) Text() string {
+	return s.text
+}
+
+// Line returns the current line along with its position.
+func (s *Scanner) Line() Line {
+	return Line{File: s.file, Number: s.line, Column: 1, Text: s.text}
+}
+
+// Next advances to the next line, returning an error describing the expected line if the input has ended.
+func (s *Scanner) Next(expected string) (Line, error) {
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return Line{}, err
+		}
+		return Line{}, s.EndOfInput(expected)
+	}
+	return s.Line(), nil
+}
+
+// EndOfInput returns an error reporting that the input ended where more was expected.
+func (s *Scanner) EndOfInput(expected string) error {
+	return &Error{File: s.file, Line: s.line + 1, Column: 1, Expected: expected}
+}
+
+// Err returns the first error encountered while reading the input.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
+
+// End checks that nothing but empty lines remains in the input.
+func (s *Scanner) End() error {
+	for s.Scan() {
+		if s.Text() != "" {
+			return s.Line().Invalid("the end of the input")
+		}
+	}
+	return s.Err()
+}
+
+// namedReader is a reader that reports a name for use in errors, in the same way as a file.
+type namedReader struct {
+	io.Reader
+	name string
+}
+
+// Name returns the name of the reader.
+func (r namedReader) Name() string {
+	return r.name
+}
+
+// NamedReader wraps r so that errors from a scanner reading it give name as the file name.
+func NamedReader(name string, r io.Reader) io.Reader {
+	return namedReader{Reader: r, name: name}
+}
+
+// Normalize converts CRLF line endings to LF and removes the line breaks from the end of an input, so that the same
+// input saved with either line ending, and with or without a final newline, is always read and hashed the same way.
+func Normalize(input []byte) []byte {
+	return bytes.TrimRight(bytes.ReplaceAll(input, []byte("\r\n"), []byte("\n")), "\r\n")
+}
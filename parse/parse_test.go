@@ -0,0 +1,145 @@
+package parse
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLineParts(t *testing.T) {
+	scanner := NewScanner(strings.NewReader("190: 10 19\n"))
+	if !scanner.Scan() {
+		t.Fatal("no line scanned")
+	}
+	value, numbers, err := scanner.Line().Cut(": ", "an equation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := value.Int(); err != nil || n != 190 {
+		t.Errorf("Int returned %d, %v", n, err)
+	}
+	if got, err := numbers.Ints(" "); err != nil || !slices.Equal(got, []int{10, 19}) {
+		t.Errorf("Ints returned %v, %v", got, err)
+	}
+	fields := scanner.Line().Fields()
+	if len(fields) != 3 || fields[2].Text != "19" || fields[2].Column != 9 {
+		t.Errorf("Fields returned %+v", fields)
+	}
+}
+
+func TestErrorPositions(t *testing.T) {
+	tests := []struct {
+		input string
+		parse func(Line) error
+		want  string
+	}{
+		{"1,2,x", func(l Line) error { _, err := l.Ints(","); return err }, `input:2:5: unexpected "x", expected an integer`},
+		{"p=1", func(l Line) error { _, err := l.TrimPrefix("v="); return err }, `input:2:1: unexpected "p=1", expected text starting with "v="`},
+		{"12a4", func(l Line) error { _, err := l.Digits(); return err }, `input:2:3: unexpected "a", expected a digit`},
+		{"#.?", func(l Line) error { return l.OneOf("#.") }, `input:2:3: unexpected "?", expected one of "#."`},
+		{"", func(l Line) error { _, err := l.Int(); return err }, `input:2:1: expected an integer`},
+		{"99999999999999999999", func(l Line) error { _, err := l.Int(); return err }, `input:2:1: unexpected "99999999999999999999", expected an integer that fits in 64 bits`},
+	}
+	for _, test := range tests {
+		scanner := NewScanner(strings.NewReader("first\n" + test.input + "\n"))
+		scanner.Scan()
+		scanner.Scan()
+		err := test.parse(scanner.Line())
+		var parseErr *Error
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%q: got error %v, want a parse error", test.input, err)
+		} else if err.Error() != test.want {
+			t.Errorf("%q: got %q, want %q", test.input, err.Error(), test.want)
+		}
+	}
+}
+
+func TestScannerFileName(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(fileName, []byte("Register A: 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := NewScanner(file)
+	if _, err := scanner.Next("a register"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = scanner.Next("a program")
+	want := fileName + ":2:1: expected a program"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
+
+func TestLineEndings(t *testing.T) {
+	want := []string{"..#", "#..", "", "1,2"}
+	for _, input := range []string{
+		"..#\n#..\n\n1,2",
+		"..#\n#..\n\n1,2\n",
+		"..#\r\n#..\r\n\r\n1,2\r\n",
+		"..#\r\n#..\r\n\r\n1,2\r\n\r\n",
+	} {
+		if got := string(Normalize([]byte(input))); got != strings.Join(want, "\n") {
+			t.Errorf("Normalize(%q) = %q, want %q", input, got, strings.Join(want, "\n"))
+		}
+
+		scanner := NewScanner(strings.NewReader(input))
+		lines := []string{}
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		if !slices.Equal(lines[:min(len(lines), len(want))], want) {
+			t.Errorf("%q: got lines %q, want %q", input, lines, want)
+		}
+		if err := scanner.End(); err != nil {
+			t.Errorf("%q: %v", input, err)
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	scanner := NewScanner(strings.NewReader("#.#\n...\n\n<>\n"))
+	g, err := Grid(scanner, "#.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Errorf("got %dx%d grid, want 3x2", g.Width(), g.Height())
+	}
+	if !scanner.Scan() || scanner.Text() != "<>" {
+		t.Error("Grid consumed the lines after the empty line")
+	}
+
+	_, err = Grid(NewScanner(strings.NewReader("#.#\n..\n")), "#.")
+	want := `input:2:1: unexpected "..", expected a row of length 3`
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+
+	if _, err := WalledGrid(NewScanner(strings.NewReader("###\n#.#\n###\n")), "#.", '#'); err != nil {
+		t.Error(err)
+	}
+	for input, want := range map[string]string{
+		"###\n..#\n###\n": `input:2:1: unexpected ".", expected '#' all around the edge of the grid`,
+		"###\n#.#\n#.#\n": `input:3:2: unexpected ".", expected '#' all around the edge of the grid`,
+		".\n":             `input:1:1: unexpected ".", expected '#' all around the edge of the grid`,
+	} {
+		if _, err := WalledGrid(NewScanner(strings.NewReader(input)), "#.", '#'); err == nil || err.Error() != want {
+			t.Errorf("%q: got %v, want %q", input, err, want)
+		}
+	}
+
+	err = NewScanner(strings.NewReader("\n\nextra\n")).End()
+	want = `input:3:1: unexpected "extra", expected the end of the input`
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
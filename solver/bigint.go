@@ -0,0 +1,9 @@
+package solver
+
+// BigSolver is implemented by solvers whose answers, or the values used to find them, can grow too large for an int.
+// By default such a solver checks its arithmetic and returns an error wrapping checked.ErrOverflow when a value
+// outgrows int. Once big integers are enabled it uses math/big instead, and answers too large for an int have kind
+// Big.
+type BigSolver interface {
+	SetBigInt(enabled bool)
+}
@@ -0,0 +1,14 @@
+package solver
+
+import (
+	"io"
+	"math/rand/v2"
+)
+
+// Generator writes random puzzle inputs, for testing a solver beyond the real input. Like a solver, a generator
+// declares its parameters, such as the size of the puzzle, as tagged int fields.
+type Generator interface {
+	// Generate writes a random input that the day's solver can parse, using rng as the only source of randomness
+	// so that the same seed always gives the same input.
+	Generate(w io.Writer, rng *rand.Rand) error
+}
@@ -0,0 +1,90 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Param is a named integer parameter of a puzzle, such as the size of the grid it takes place on.
+//
+// A solver or generator declares its parameters as int fields of its struct tagged with the parameter name:
+//
+//	type Solver struct {
+//		GridSize int `param:"gridSize"`
+//	}
+type Param struct {
+	Name  string
+	Value int
+}
+
+// Params returns the current value of every parameter declared by a solver or generator.
+func Params(s any) []Param {
+	params := []Param{}
+	value, fields := paramFields(s)
+	for i, field := range fields {
+		if name, ok := field.Tag.Lookup("param"); ok {
+			params = append(params, Param{Name: name, Value: int(value.Field(i).Int())})
+		}
+	}
+	return params
+}
+
+// SetParam sets the named parameter of a solver or generator.
+func SetParam(s any, name string, value int) error {
+	structValue, fields := paramFields(s)
+	for i, field := range fields {
+		if tag, ok := field.Tag.Lookup("param"); ok && tag == name {
+			structValue.Field(i).SetInt(int64(value))
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown parameter %q", name)
+}
+
+// Validator is implemented by solvers whose parameters can take values that make no sense, such as a grid of no
+// size, or that would need far more memory or time than any puzzle does.
+type Validator interface {
+	// Validate returns an error describing the first parameter whose value cannot be used.
+	Validate() error
+}
+
+// SetParams sets each of the named parameters of a solver or generator, and then checks them all if it is a
+// Validator.
+func SetParams(s any, params map[string]int) error {
+	for name, value := range params {
+		if err := SetParam(s, name, value); err != nil {
+			return err
+		}
+	}
+	if v, ok := s.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// CheckRange returns an error if the value of the named parameter is not from low to high, for use by Validate.
+func CheckRange(name string, value, low, high int) error {
+	if value < low || value > high {
+		return fmt.Errorf("%s must be from %d to %d, not %d", name, low, high, value)
+	}
+	return nil
+}
+
+// paramFields returns the struct value behind a solver or generator along with its fields, or no fields if it is not
+// a pointer to a struct.
+func paramFields(s any) (reflect.Value, []reflect.StructField) {
+	value := reflect.ValueOf(s)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+	value = value.Elem()
+	fields := []reflect.StructField{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if _, ok := field.Tag.Lookup("param"); ok && field.Type.Kind() != reflect.Int {
+			panic(fmt.Sprintf("solver: parameter field %s of %s must be an int", field.Name, value.Type()))
+		}
+		fields = append(fields, field)
+	}
+	return value, fields
+}
@@ -0,0 +1,42 @@
+package solver
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+)
+
+type paramSolver struct {
+	Width  int `param:"width"`
+	Height int `param:"height"`
+	Other  int
+}
+
+func (s *paramSolver) Parse(r io.Reader) error                 { return nil }
+func (s *paramSolver) PartOne(context.Context) (Answer, error) { return IntAnswer(s.Width), nil }
+func (s *paramSolver) PartTwo(context.Context) (Answer, error) { return IntAnswer(s.Height), nil }
+func (s *paramSolver) Validate() error                         { return CheckRange("width", s.Width, 1, 1000) }
+
+func TestParams(t *testing.T) {
+	s := &paramSolver{Width: 101, Height: 103}
+	want := []Param{{Name: "width", Value: 101}, {Name: "height", Value: 103}}
+	if got := Params(s); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if err := SetParams(s, map[string]int{"width": 11, "height": 7}); err != nil {
+		t.Fatal(err)
+	}
+	if s.Width != 11 || s.Height != 7 {
+		t.Errorf("parameters not set: %+v", s)
+	}
+	if err := SetParam(s, "Other", 1); err == nil {
+		t.Error("expected an error for an untagged field")
+	}
+
+	err := SetParams(s, map[string]int{"width": 0})
+	if err == nil || err.Error() != "width must be from 1 to 1000, not 0" {
+		t.Errorf("got error %v, want the width to be out of range", err)
+	}
+}
@@ -0,0 +1,174 @@
+// Package solver defines the interface shared by the solutions for each day.
+package solver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+)
+
+// ErrNoPart is returned by puzzles that do not have the requested part.
+var ErrNoPart = errors.New("puzzle has no such part")
+
+// ErrUnsolved is returned by the stubs of parts that have not been solved yet.
+var ErrUnsolved = errors.New("puzzle has not been solved yet")
+
+// Solver solves both parts of a puzzle.
+type Solver interface {
+	// Parse reads the puzzle input. It must be called before either part is solved.
+	Parse(r io.Reader) error
+	// PartOne solves part one of the puzzle. It stops early, returning the context's error, once ctx is done.
+	PartOne(ctx context.Context) (Answer, error)
+	// PartTwo solves part two of the puzzle. It stops early, returning the context's error, once ctx is done.
+	PartTwo(ctx context.Context) (Answer, error)
+}
+
+// Versioned is implemented by solvers that report a version. The version must change whenever a change to the solver
+// could change its answers, as answers are cached under it.
+type Versioned interface {
+	Version() string
+}
+
+// Kind is the type of value held by an answer.
+type Kind int
+
+const (
+	// None is the kind of an answer that has no value, such as when the answer has to be spotted by eye.
+	None Kind = iota
+	// Int is the kind of an integer answer.
+	Int
+	// String is the kind of a string answer.
+	String
+	// Big is the kind of an integer answer that is too large for an int.
+	Big
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case None:
+		return "none"
+	case Int:
+		return "int"
+	case String:
+		return "string"
+	case Big:
+		return "big"
+	}
+	return "unknown"
+}
+
+// Answer is the answer to one part of a puzzle. The zero value is an answer of kind None. Answers can be compared
+// with ==.
+type Answer struct {
+	kind  Kind
+	value int
+	text  string // the text of a string answer, or the digits of a big one
+}
+
+// IntAnswer creates an integer answer.
+func IntAnswer(value int) Answer {
+	return Answer{kind: Int, value: value}
+}
+
+// BigAnswer creates an integer answer from a big integer. The answer has kind Int if the value fits in an int, so
+// that it is the same as the answer found without math/big, and kind Big otherwise.
+func BigAnswer(value *big.Int) Answer {
+	if value.IsInt64() && strconv.IntSize == 64 {
+		return IntAnswer(int(value.Int64()))
+	}
+	return Answer{kind: Big, text: value.String()}
+}
+
+// StringAnswer creates a string answer.
+func StringAnswer(text string) Answer {
+	return Answer{kind: String, text: text}
+}
+
+// Kind returns the type of value held by the answer.
+func (a Answer) Kind() Kind {
+	return a.kind
+}
+
+// Int returns the value of an integer answer, or 0 for answers of any other kind.
+func (a Answer) Int() int {
+	return a.value
+}
+
+// Big returns the value of an integer answer of either kind Int or kind Big, or nil for answers of any other kind.
+func (a Answer) Big() *big.Int {
+	switch a.kind {
+	case Int:
+		return big.NewInt(int64(a.value))
+	case Big:
+		value, _ := new(big.Int).SetString(a.text, 10)
+		return value
+	}
+	return nil
+}
+
+// String returns the answer as it would be entered on the puzzle page, or an empty string for answers of kind None.
+func (a Answer) String() string {
+	switch a.kind {
+	case Int:
+		return strconv.Itoa(a.value)
+	case String, Big:
+		return a.text
+	}
+	return ""
+}
+
+// answerJSON is the JSON encoding of an answer.
+type answerJSON struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value,omitempty"`
+}
+
+// MarshalJSON encodes the answer as its kind and text, so that it can be stored and read back unchanged.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(answerJSON{Kind: a.kind.String(), Value: a.String()})
+}
+
+// UnmarshalJSON decodes an answer encoded by MarshalJSON.
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	var encoded answerJSON
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	switch encoded.Kind {
+	case None.String():
+		*a = Answer{}
+	case Int.String():
+		value, err := strconv.Atoi(encoded.Value)
+		if err != nil {
+			return fmt.Errorf("invalid int answer %q", encoded.Value)
+		}
+		*a = IntAnswer(value)
+	case String.String():
+		*a = StringAnswer(encoded.Value)
+	case Big.String():
+		value, ok := new(big.Int).SetString(encoded.Value, 10)
+		if !ok {
+			return fmt.Errorf("invalid big answer %q", encoded.Value)
+		}
+		*a = BigAnswer(value)
+	default:
+		return fmt.Errorf("unknown answer kind %q", encoded.Kind)
+	}
+	return nil
+}
+
+// Part solves the given part of the puzzle, where part is 1 or 2.
+func Part(ctx context.Context, s Solver, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return s.PartOne(ctx)
+	case 2:
+		return s.PartTwo(ctx)
+	}
+	return Answer{}, ErrNoPart
+}
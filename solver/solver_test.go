@@ -0,0 +1,56 @@
+package solver
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigAnswer(t *testing.T) {
+	small := BigAnswer(big.NewInt(math.MaxInt))
+	if small != IntAnswer(math.MaxInt) {
+		t.Errorf("got %s answer %s for a value that fits in an int, want an int answer", small.Kind(), small)
+	}
+
+	value := new(big.Int).Lsh(big.NewInt(1), 64)
+	large := BigAnswer(value)
+	if large.Kind() != Big || large.String() != "18446744073709551616" || large.Big().Cmp(value) != 0 {
+		t.Errorf("got %s answer %s, want big answer %s", large.Kind(), large, value)
+	}
+	if large != BigAnswer(new(big.Int).Set(value)) {
+		t.Error("big answers with the same value are not equal")
+	}
+	if StringAnswer("x").Big() != nil {
+		t.Error("got a big value for a string answer")
+	}
+}
+
+func TestAnswerJSON(t *testing.T) {
+	answers := []Answer{
+		{},
+		IntAnswer(-42),
+		StringAnswer("bkr,mqh"),
+		BigAnswer(new(big.Int).Lsh(big.NewInt(1), 70)),
+	}
+	for _, answer := range answers {
+		data, err := json.Marshal(answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Answer
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("%s: %v", data, err)
+		}
+		if decoded != answer {
+			t.Errorf("%s: decoded %s answer %s, want %s answer %s", data, decoded.Kind(), decoded, answer.Kind(), answer)
+		}
+	}
+
+	for _, data := range []string{`{"kind":"int","value":"x"}`, `{"kind":"big","value":"1.5"}`, `{"kind":"float"}`} {
+		var decoded Answer
+		if err := json.Unmarshal([]byte(data), &decoded); err == nil {
+			t.Errorf("%s: decoded %s answer %s, want an error", data, decoded.Kind(), decoded)
+		}
+	}
+}
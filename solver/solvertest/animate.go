@@ -0,0 +1,34 @@
+package solvertest
+
+import (
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/animate"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Frames parses the named input file with a fresh solver and returns every frame of its animation of a part,
+// failing the test if there are none.
+func Frames[S interface {
+	solver.Solver
+	animate.Animator
+}](t *testing.T, newSolver func() S, fileName string, params map[string]int, part int) []animate.Frame {
+	t.Helper()
+	s := newSolver()
+	if err := Parse(s, fileName, params); err != nil {
+		t.Fatal(err)
+	}
+	seq, err := s.Animate(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frames := []animate.Frame{}
+	for frame := range seq {
+		frames = append(frames, frame)
+	}
+	if len(frames) == 0 {
+		t.Fatalf("part %d has no frames", part)
+	}
+	return frames
+}
@@ -0,0 +1,34 @@
+package solvertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// Certified parses the named input file with a fresh solver and certifies its answer to a part, failing the test if
+// the answer differs from the one the part gives or the witness does not verify.
+func Certified[S interface {
+	solver.Solver
+	solver.Certifier
+}](t *testing.T, newSolver func() S, fileName string, params map[string]int, part int) (solver.Answer, solver.Witness) {
+	t.Helper()
+	s := newSolver()
+	if err := Parse(s, fileName, params); err != nil {
+		t.Fatal(err)
+	}
+	answer, witness, err := s.Certify(context.Background(), part)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, err := solver.Part(context.Background(), s, part); err != nil {
+		t.Fatal(err)
+	} else if answer != want {
+		t.Fatalf("certified answer %q, but part %d gives %q", answer, part, want)
+	}
+	if err := s.Verify(part, answer, witness); err != nil {
+		t.Fatalf("witness for %q does not verify: %v", answer, err)
+	}
+	return answer, witness
+}
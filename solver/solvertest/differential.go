@@ -0,0 +1,90 @@
+package solvertest
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// DifferentialCases is the number of random inputs that Differential tries, of which a tenth are tried in short
+// mode.
+const DifferentialCases = 100
+
+// Differential checks a fast implementation against a slow reference implementation that is obviously correct, on
+// random inputs from a generator that should be set up to make small puzzles. Both are given a freshly parsed
+// solver, so they may use its unexported fields. If they ever disagree, the test fails with the smallest input that
+// can be made, by removing lines, on which they still disagree.
+func Differential[S solver.Solver](t *testing.T, g solver.Generator, newSolver func() S,
+	fast, reference func(S) (solver.Answer, error)) {
+	t.Helper()
+	cases := DifferentialCases
+	if testing.Short() {
+		cases /= 10
+	}
+
+	disagree := func(input []byte) bool {
+		fastResult, parsed := run(input, newSolver, fast)
+		referenceResult, _ := run(input, newSolver, reference)
+		return parsed && fastResult != referenceResult
+	}
+	for seed := uint64(1); seed <= uint64(cases); seed++ {
+		input := Generate(t, g, seed)
+		if !disagree(input) {
+			continue
+		}
+
+		input = Shrink(input, disagree)
+		fastResult, _ := run(input, newSolver, fast)
+		referenceResult, _ := run(input, newSolver, reference)
+		t.Errorf("seed %d: got %s but the reference gives %s for the input:\n%s", seed, fastResult, referenceResult,
+			input)
+		return
+	}
+}
+
+// run parses an input, with its line endings normalized as aoc run does, with a fresh solver and describes the outcome of f, which may be an answer, an error or a
+// panic. It returns false if the input does not parse.
+func run[S solver.Solver](input []byte, newSolver func() S, f func(S) (solver.Answer, error)) (result string,
+	parsed bool) {
+	s := newSolver()
+	if err := s.Parse(bytes.NewReader(parse.Normalize(input))); err != nil {
+		return "", false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result = fmt.Sprintf("panic: %v", r)
+		}
+	}()
+	answer, err := f(s)
+	if err != nil {
+		return "error: " + err.Error(), true
+	}
+	return fmt.Sprintf("%q", answer.String()), true
+}
+
+// Shrink removes as many lines as it can from an input while fails still reports true for it, trying to remove
+// large blocks of lines before smaller ones.
+func Shrink(input []byte, fails func([]byte) bool) []byte {
+	lines := strings.SplitAfter(string(input), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	for size := len(lines) / 2; size >= 1; size /= 2 {
+		for start := 0; start < len(lines); {
+			candidate := slices.Concat(lines[:start], lines[min(start+size, len(lines)):])
+			if fails([]byte(strings.Join(candidate, ""))) {
+				lines = candidate
+			} else {
+				start += size
+			}
+		}
+	}
+	return []byte(strings.Join(lines, ""))
+}
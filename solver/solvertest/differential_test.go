@@ -0,0 +1,34 @@
+package solvertest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShrink(t *testing.T) {
+	input := []byte{}
+	for i := 1; i <= 20; i++ {
+		input = append(input, []byte(strings.Repeat("x", i)+"\n")...)
+	}
+
+	// The input fails whenever it still has the lines of length 7 and 13.
+	calls := 0
+	fails := func(candidate []byte) bool {
+		calls++
+		lines := strings.Split(string(candidate), "\n")
+		found := 0
+		for _, line := range lines {
+			if len(line) == 7 || len(line) == 13 {
+				found++
+			}
+		}
+		return found == 2
+	}
+	got := string(Shrink(input, fails))
+	if want := strings.Repeat("x", 7) + "\n" + strings.Repeat("x", 13) + "\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if calls > 100 {
+		t.Errorf("made %d calls, want far fewer than trying every subset", calls)
+	}
+}
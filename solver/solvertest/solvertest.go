@@ -0,0 +1,232 @@
+// Package solvertest runs solvers against the expected answers recorded alongside each day's inputs.
+package solvertest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// ManifestFile is the name of the file, within each day's directory, that records the expected answers.
+const ManifestFile = "expected.json"
+
+// Case is the expected outcome of solving one input file.
+type Case struct {
+	// Input is the name of the input file, relative to the day's directory.
+	Input string `json:"input"`
+	// Params overrides the solver's default parameters, for examples that use a smaller puzzle.
+	Params map[string]int `json:"params,omitempty"`
+	// PartOne and PartTwo are the expected answers. A part with no expected answer is not checked.
+	PartOne *string `json:"partOne,omitempty"`
+	PartTwo *string `json:"partTwo,omitempty"`
+	// Slow marks cases that are skipped in short mode.
+	Slow bool `json:"slow,omitempty"`
+}
+
+// ReadManifest reads the cases from a manifest file.
+func ReadManifest(fileName string) ([]Case, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	cases := []Case{}
+	if err := json.Unmarshal(data, &cases); err != nil {
+		return nil, err
+	}
+	return cases, nil
+}
+
+// Golden runs every case in the manifest in the current directory. Both parts of a case share one solver and run at
+// the same time, as they do in the command, so running the tests with -race catches a part that changes the parsed
+// input.
+func Golden[S solver.Solver](t *testing.T, newSolver func() S) {
+	t.Helper()
+	golden(t, newSolver)
+}
+
+// GoldenBigInt runs every case in the manifest in the current directory as Golden does, but with big integers
+// enabled, checking that they give the same answers as plain ints.
+func GoldenBigInt[S interface {
+	solver.Solver
+	solver.BigSolver
+}](t *testing.T, newSolver func() S) {
+	t.Helper()
+	golden(t, func() S {
+		s := newSolver()
+		s.SetBigInt(true)
+		return s
+	})
+}
+
+// golden runs every case in the manifest in the current directory with solvers from newSolver.
+func golden[S solver.Solver](t *testing.T, newSolver func() S) {
+	t.Helper()
+	cases, err := ReadManifest(ManifestFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name(), func(t *testing.T) {
+			if c.Slow && testing.Short() {
+				t.Skip("skipping slow input in short mode")
+			}
+			if _, err := os.Stat(c.Input); errors.Is(err, fs.ErrNotExist) {
+				t.Skipf("input %s is not present", c.Input)
+			}
+
+			s := newSolver()
+			if err := Parse(s, c.Input, c.Params); err != nil {
+				t.Fatal(err)
+			}
+			expected := []*string{c.PartOne, c.PartTwo}
+			answers, errs := make([]solver.Answer, len(expected)), make([]error, len(expected))
+			var wg sync.WaitGroup
+			for i := range expected {
+				if expected[i] != nil {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						answers[i], errs[i] = solver.Part(context.Background(), s, i+1)
+					}()
+				}
+			}
+			wg.Wait()
+
+			for i := range expected {
+				if expected[i] == nil {
+					continue
+				}
+				t.Run(partName(i+1), func(t *testing.T) {
+					if errs[i] != nil {
+						t.Fatal(errs[i])
+					}
+					if answers[i].String() != *expected[i] {
+						t.Errorf("got %q, want %q", answers[i].String(), *expected[i])
+					}
+				})
+			}
+		})
+	}
+}
+
+// Parse sets the parameters of a solver and parses the named input file with it, after normalizing its line endings
+// as aoc run does.
+func Parse(s solver.Solver, fileName string, params map[string]int) error {
+	if err := solver.SetParams(s, params); err != nil {
+		return err
+	}
+
+	input, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	return s.Parse(parse.NamedReader(fileName, bytes.NewReader(parse.Normalize(input))))
+}
+
+// Name returns the name of a case, which is also the name of its subtest: the input file, followed by any parameters.
+func (c Case) Name() string {
+	if len(c.Params) == 0 {
+		return c.Input
+	}
+	params := []string{}
+	for name, value := range c.Params {
+		params = append(params, fmt.Sprintf("%s=%d", name, value))
+	}
+	slices.Sort(params)
+	return c.Input + "(" + strings.Join(params, ",") + ")"
+}
+
+// partName returns the name of the subtest for a part.
+func partName(part int) string {
+	if part == 1 {
+		return "partOne"
+	}
+	return "partTwo"
+}
+
+// BenchmarkInput is the name of the input file, within each day's directory, that benchmarks are run against.
+const BenchmarkInput = "input.txt"
+
+// Benchmark benchmarks one part of a solver against the input file in the current directory.
+func Benchmark[S solver.Solver](b *testing.B, newSolver func() S, part int) {
+	b.Helper()
+	input, err := os.ReadFile(BenchmarkInput)
+	if errors.Is(err, fs.ErrNotExist) {
+		b.Skipf("input %s is not present", BenchmarkInput)
+	} else if err != nil {
+		b.Fatal(err)
+	}
+	BenchmarkBytes(b, newSolver, part, input)
+}
+
+// BenchmarkBytes benchmarks one part of a solver against an input, whose line endings are normalized as aoc run does.
+// Each iteration parses the input into a fresh solver with the timer stopped, so that only solving the part is
+// measured and no iteration sees changes that an earlier one made to the parsed input.
+func BenchmarkBytes[S solver.Solver](b *testing.B, newSolver func() S, part int, input []byte) {
+	b.Helper()
+	b.ReportAllocs()
+	input = parse.Normalize(input)
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := newSolver()
+		if err := s.Parse(bytes.NewReader(input)); err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		if _, err := solver.Part(context.Background(), s, part); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Generated checks that the inputs written by a generator with a few different seeds can be solved by a fresh solver,
+// and that the same seed always gives the same input. The generator is left at its defaults, which make inputs the
+// size of the real puzzle, so that the check also catches inputs too large for the solver, such as those whose
+// answers would not fit in an int. Parts are only solved outside short mode.
+func Generated[G solver.Generator, S solver.Solver](t *testing.T, newGenerator func() G, newSolver func() S) {
+	t.Helper()
+	for seed := uint64(1); seed <= 3; seed++ {
+		input := Generate(t, newGenerator(), seed)
+		if again := Generate(t, newGenerator(), seed); !bytes.Equal(input, again) {
+			t.Errorf("seed %d gave different inputs", seed)
+		}
+		s := newSolver()
+		if err := s.Parse(bytes.NewReader(parse.Normalize(input))); err != nil {
+			t.Errorf("seed %d: %v", seed, err)
+			continue
+		}
+		if testing.Short() {
+			continue
+		}
+		for part := 1; part <= 2; part++ {
+			if _, err := solver.Part(context.Background(), s, part); err != nil && !errors.Is(err, solver.ErrNoPart) {
+				t.Errorf("seed %d part %d: %v", seed, part, err)
+			}
+		}
+	}
+}
+
+// Generate writes an input with a generator seeded with seed, failing the test if the generator fails.
+func Generate(t testing.TB, g solver.Generator, seed uint64) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	if err := g.Generate(&buffer, rand.New(rand.NewPCG(seed, seed))); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
@@ -0,0 +1,99 @@
+package solvertest
+
+import (
+	"context"
+	"flag"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/markcooper37/aoc-2024/parse"
+	"github.com/markcooper37/aoc-2024/solver"
+)
+
+// crlfInput is an input saved with CRLF line endings and a blank line at the end, which should be read as two lines.
+const crlfInput = "1\r\n2\r\n\r\n"
+
+// sumSolver adds up a number on each line, failing on blank lines.
+type sumSolver struct {
+	numbers []int
+}
+
+func (s *sumSolver) Parse(r io.Reader) error {
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		n, err := scanner.Line().Int()
+		if err != nil {
+			return err
+		}
+		s.numbers = append(s.numbers, n)
+	}
+	return scanner.Err()
+}
+
+func (s *sumSolver) PartOne(context.Context) (solver.Answer, error) {
+	sum := 0
+	for _, n := range s.numbers {
+		sum += n
+	}
+	return solver.IntAnswer(sum), nil
+}
+
+func (s *sumSolver) PartTwo(context.Context) (solver.Answer, error) {
+	return solver.IntAnswer(len(s.numbers)), nil
+}
+
+// crlfGenerator writes crlfInput.
+type crlfGenerator struct{}
+
+func (crlfGenerator) Generate(w io.Writer, _ *rand.Rand) error {
+	_, err := io.WriteString(w, crlfInput)
+	return err
+}
+
+func TestParseNormalizes(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(fileName, []byte(crlfInput), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s := &sumSolver{}
+	if err := Parse(s, fileName, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.numbers) != 2 {
+		t.Errorf("got %d numbers, want 2", len(s.numbers))
+	}
+}
+
+func TestBenchmarkBytesNormalizes(t *testing.T) {
+	setBenchTime(t, "1x")
+	result := testing.Benchmark(func(b *testing.B) {
+		BenchmarkBytes(b, func() *sumSolver { return &sumSolver{} }, 1, []byte(crlfInput))
+	})
+	if result.N == 0 {
+		t.Error("the benchmark failed to parse the input")
+	}
+}
+
+func TestGeneratedNormalizes(t *testing.T) {
+	Generated(t, func() crlfGenerator { return crlfGenerator{} }, func() *sumSolver { return &sumSolver{} })
+}
+
+func TestDifferentialNormalizes(t *testing.T) {
+	count := func(s *sumSolver) (solver.Answer, error) { return solver.IntAnswer(len(s.numbers)), nil }
+	if result, parsed := run([]byte(crlfInput), func() *sumSolver { return &sumSolver{} }, count); !parsed ||
+		result != `"2"` {
+		t.Errorf("got %s, %t, want two numbers", result, parsed)
+	}
+}
+
+// setBenchTime sets the time, or count, that testing.Benchmark runs a benchmark for until the end of the test.
+func setBenchTime(t *testing.T, benchTime string) {
+	previous := flag.Lookup("test.benchtime").Value.String()
+	if err := flag.Set("test.benchtime", benchTime); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { flag.Set("test.benchtime", previous) })
+}
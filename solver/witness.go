@@ -0,0 +1,25 @@
+package solver
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoWitness is returned by Certify for a part whose answer has no witness.
+var ErrNoWitness = errors.New("no witness for this part")
+
+// Witness is evidence for an answer that can be checked against the input much more easily than the puzzle can be
+// solved, such as the path through a maze that scores the answer.
+type Witness interface {
+	// String describes the witness, one item to a line.
+	String() string
+}
+
+// Certifier is implemented by solvers that can show why their answers are right.
+type Certifier interface {
+	// Certify solves a part of the puzzle, returning a witness along with the answer.
+	Certify(ctx context.Context, part int) (Answer, Witness, error)
+	// Verify checks that a witness supports an answer to a part for the parsed input, without using any of the code
+	// that found the answer. It returns an error describing the first problem it finds.
+	Verify(part int, answer Answer, witness Witness) error
+}